@@ -0,0 +1,88 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// benchmark holds a built-in test problem
+type benchmark struct {
+	desc       string                     // description
+	nf, ng, nh int                        // number of objective functions, inequalities and equalities
+	ndim       int                        // default number of floats
+	fixed      bool                       // number of floats cannot be changed
+	xmin, xmax float64                    // default bounds of floats
+	fref       []float64                  // reference (best) objective values
+	fcn        func(f, g, h, x []float64) // function
+}
+
+// benchmarks holds the built-in test problems that are not in package problems
+//  Note: the problems of package problems (e.g. ZDT1, DTLZ2, WFG4, UF1, CF1, G01 or BBOB1) are
+//        also available; see problems.New
+var benchmarks = map[string]benchmark{
+
+	"sphere": {desc: "sphere function", nf: 1, ndim: 10, xmin: -5, xmax: 5, fref: []float64{0},
+		fcn: func(f, g, h, x []float64) {
+			f[0] = 0
+			for _, v := range x {
+				f[0] += v * v
+			}
+		}},
+
+	"rosenbrock": {desc: "Rosenbrock function", nf: 1, ndim: 10, xmin: -2.048, xmax: 2.048, fref: []float64{0},
+		fcn: func(f, g, h, x []float64) {
+			f[0] = 0
+			for i := 0; i < len(x)-1; i++ {
+				f[0] += 100.0*math.Pow(x[i+1]-x[i]*x[i], 2.0) + math.Pow(1.0-x[i], 2.0)
+			}
+		}},
+
+	"rastrigin": {desc: "Rastrigin function", nf: 1, ndim: 10, xmin: -5.12, xmax: 5.12, fref: []float64{0},
+		fcn: func(f, g, h, x []float64) {
+			f[0] = 10.0 * float64(len(x))
+			for _, v := range x {
+				f[0] += v*v - 10.0*math.Cos(2.0*math.Pi*v)
+			}
+		}},
+
+	"ackley": {desc: "Ackley function", nf: 1, ndim: 10, xmin: -32.768, xmax: 32.768, fref: []float64{0},
+		fcn: func(f, g, h, x []float64) {
+			n := float64(len(x))
+			s1, s2 := 0.0, 0.0
+			for _, v := range x {
+				s1 += v * v
+				s2 += math.Cos(2.0 * math.Pi * v)
+			}
+			f[0] = -20.0*math.Exp(-0.2*math.Sqrt(s1/n)) - math.Exp(s2/n) + 20.0 + math.E
+		}},
+
+	"griewank": {desc: "Griewank function", nf: 1, ndim: 10, xmin: -600, xmax: 600, fref: []float64{0},
+		fcn: func(f, g, h, x []float64) {
+			s, p := 0.0, 1.0
+			for i, v := range x {
+				s += v * v
+				p *= math.Cos(v / math.Sqrt(float64(i+1)))
+			}
+			f[0] = 1.0 + s/4000.0 - p
+		}},
+
+	"deb1": {desc: "Deb (2000) constrained problem 1", nf: 1, ng: 2, ndim: 2, fixed: true, xmin: 0, xmax: 6, fref: []float64{13.59085},
+		fcn: func(f, g, h, x []float64) {
+			f[0] = math.Pow(x[0]*x[0]+x[1]-11.0, 2.0) + math.Pow(x[0]+x[1]*x[1]-7.0, 2.0)
+			g[0] = 4.84 - math.Pow(x[0]-0.05, 2.0) - math.Pow(x[1]-2.5, 2.0)
+			g[1] = x[0]*x[0] + math.Pow(x[1]-2.5, 2.0) - 4.84
+		}},
+}
+
+// benchmarkNames returns the sorted names of built-in benchmarks that are not in package problems
+func benchmarkNames() (names []string) {
+	for name := range benchmarks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return
+}
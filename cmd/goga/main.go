@@ -0,0 +1,293 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// goga solves optimisation problems defined in problem files
+//  Usage:
+//   goga <command> [options] <problem-file>
+//  Commands:
+//   run    -- solves the problem once and writes results, parameters and log
+//   many   -- solves the problem Nsamples times (RunMany) and prints statistics
+//   report -- solves the problem Nsamples times and writes a TeX report
+//   plot   -- solves the problem once and plots initial and final solutions
+//   list   -- lists the built-in benchmarks
+//  Problem files are JSON, YAML or TOML files; see Problem
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/goga/problems"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+)
+
+// options holds command line options
+type options struct {
+	dirout  string // output directory
+	fnkey   string // filename key. "" means name of problem
+	verbose bool   // show messages during optimisation
+	pdf     bool   // generate PDF of report
+	ext     string // extension of figures
+	front   bool   // plot feasible solutions only (Pareto front)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	err := run(os.Args[1], os.Args[2:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "goga: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// usage prints help message
+func usage() {
+	fmt.Fprintf(os.Stderr, `usage: goga <command> [options] <problem-file>
+
+commands:
+  run     solve problem once and write results, parameters and log
+  many    solve problem Nsamples times and print statistics
+  report  solve problem Nsamples times and write TeX report
+  plot    solve problem once and plot initial and final solutions
+  list    list built-in benchmarks
+
+run 'goga <command> -h' for the options of each command
+`)
+}
+
+// run runs command with arguments
+//  Note: panics (e.g. chk.Panic in goga) and failures of the problem function are returned as errors
+func run(command string, args []string) (err error) {
+
+	// failures
+	var prob *Problem
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s failed:\n%v", command, r)
+		}
+		if err == nil && prob != nil {
+			err = prob.Failure()
+		}
+	}()
+
+	// list and help
+	switch command {
+	case "list":
+		for _, name := range benchmarkNames() {
+			b := benchmarks[name]
+			io.Pf("%-12s nf=%d ng=%d nh=%d ndim=%-3d %s\n", name, b.nf, b.ng, b.nh, b.ndim, b.desc)
+		}
+		for _, name := range problems.Names() {
+			p, err := problems.New(name, 0, 0)
+			if err != nil {
+				return err
+			}
+			io.Pf("%-12s nf=%d ng=%d nh=%d ndim=%-3d package problems\n", name, p.Nf, p.Ng, p.Nh, len(p.Xmin))
+		}
+		return
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	case "run", "many", "report", "plot":
+	default:
+		usage()
+		return fmt.Errorf("unknown command %q", command)
+	}
+
+	// options
+	var o options
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	flags.StringVar(&o.dirout, "dir", "/tmp/goga", "output directory")
+	flags.StringVar(&o.fnkey, "key", "", "filename key of output files (default: name of problem)")
+	flags.BoolVar(&o.verbose, "v", false, "show messages during optimisation")
+	switch command {
+	case "report":
+		flags.BoolVar(&o.pdf, "pdf", false, "generate PDF of report with pdflatex")
+	case "plot":
+		flags.StringVar(&o.ext, "ext", ".png", "extension (format) of figure")
+		flags.BoolVar(&o.front, "front", false, "plot feasible solutions only")
+	}
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: goga %s [options] <problem-file>\n\noptions:\n", command)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("one problem file must be given")
+	}
+
+	// problem
+	prob, opt, err := ReadProblem(flags.Arg(0), o.verbose)
+	if err != nil {
+		return
+	}
+	if o.fnkey == "" {
+		o.fnkey = prob.Name
+	}
+
+	// command
+	switch command {
+	case "run":
+		return cmdRun(opt, &o)
+	case "many":
+		return cmdMany(opt, &o)
+	case "report":
+		return cmdReport(opt, &o)
+	}
+	return cmdPlot(opt, &o)
+}
+
+// cmdRun solves problem once
+//  Output files: <key>.res (all solutions), <key>-prms.json (parameters) and <key>.log
+func cmdRun(opt *goga.Optimiser, o *options) (err error) {
+	opt.Solve()
+	err = writeOutput(opt, o)
+	if err != nil {
+		return
+	}
+	best, feasible := goga.GetBestFeasible(opt, 0)
+	io.Pf("nfeval = %d\n", opt.Nfeval)
+	if best == nil {
+		io.Pfred("there are no feasible solutions\n")
+		return
+	}
+	if opt.Nova > 1 {
+		nfront := 0
+		for _, sol := range feasible {
+			if sol.FrontId == 0 {
+				nfront++
+			}
+		}
+		io.Pf("number of feasible solutions = %d\n", len(feasible))
+		io.Pf("number of solutions on front = %d\n", nfront)
+	}
+	io.Pf("best solution:\n")
+	if opt.Nflt > 0 {
+		io.Pf("  x = %v\n", best.Flt)
+	}
+	if opt.Nint > 0 {
+		io.Pf("  y = %v\n", best.Int)
+	}
+	io.Pf("  f = %v\n", best.Ova)
+	io.Pf("results written to %s\n", filepath.Join(o.dirout, o.fnkey+".res"))
+	return
+}
+
+// cmdMany solves problem Nsamples times and prints statistics of best objective values
+//  Output files: <key>-NNNN_*.res (initial and final solutions of each trial), <key>-prms.json
+//  and <key>.log
+func cmdMany(opt *goga.Optimiser, o *options) (err error) {
+	opt.RunMany(o.dirout, o.fnkey)
+	err = writeOutput(opt, o)
+	if err != nil {
+		return
+	}
+	for i := 0; i < opt.Nova; i++ {
+		if opt.Nova > 1 {
+			io.Pf("\nobjective %d\n", i)
+		}
+		goga.StatF(opt, i, true)
+	}
+	if len(opt.Multi_err) > 1 || len(opt.Multi_IGD) > 1 {
+		goga.StatMulti(opt, true)
+	}
+	io.Pf("\ntotal time = %v, average time per trial = %v\n", opt.SysTimeTot, opt.SysTimeAve)
+	return
+}
+
+// cmdReport solves problem Nsamples times and writes TeX report
+//  Output files: <key>.tex (and <key>.pdf if requested) in addition to the files of cmdMany
+func cmdReport(opt *goga.Optimiser, o *options) (err error) {
+	opt.RunMany(o.dirout, o.fnkey)
+	err = writeOutput(opt, o)
+	if err != nil {
+		return
+	}
+	rpt := goga.NewTexReport([]*goga.Optimiser{opt})
+	rpt.DirOut = o.dirout
+	rpt.Fnkey = o.fnkey
+	rpt.Title = opt.RptName
+	rpt.RunPDF = o.pdf
+	rpt.ShowX01 = opt.Nflt > 1
+	if opt.Nova > 1 {
+		rpt.Type = 3
+	}
+	rpt.Generate()
+	io.Pf("report written to %s\n", filepath.Join(o.dirout, o.fnkey+".tex"))
+	return
+}
+
+// cmdPlot solves problem once and plots initial and final solutions
+//  Plots: f0-f1 (multi-objective), x0-f0 (one float) or x0-x1 with contour of f0 (two or more
+//  floats and no ints)
+func cmdPlot(opt *goga.Optimiser, o *options) (err error) {
+	sols0 := opt.GetSolutionsCopy()
+	opt.Solve()
+	err = writeOutput(opt, o)
+	if err != nil {
+		return
+	}
+	fmtIni := plt.Fmt{L: "initial", M: "o", C: "k", Ls: "none", Ms: 3}
+	fmtFin := plt.Fmt{L: "final", M: "o", C: "r", Ls: "none", Ms: 6}
+	best, _ := goga.GetBestFeasible(opt, 0)
+	switch {
+	case opt.Nova > 1:
+		goga.PlotOvaOvaPareto(opt, sols0, 0, 1, o.front, &fmtFin, &plt.Fmt{L: "front", C: "b", Ls: "-", M: "."})
+	case opt.Nflt == 1:
+		opt.PlotAddFltOva(0, 0, sols0, 1, fmtIni, false)
+		opt.PlotAddFltOva(0, 0, opt.Solutions, 1, fmtFin, true)
+		if best != nil {
+			plt.PlotOne(best.Flt[0], best.Ova[0], "'g*', markeredgecolor='g', label='best', clip_on=0, zorder=20")
+		}
+		plt.Gll("$x_0$", "$f_0$", "leg_out=1, leg_ncol=4, leg_hlen=1.5")
+	case opt.Nflt > 1:
+		if opt.Nint == 0 {
+			var cprms goga.ContourParams
+			if opt.Nflt > 2 {
+				cprms.Refx = make([]float64, opt.Nflt)
+				if best != nil {
+					copy(cprms.Refx, best.Flt)
+				}
+			}
+			opt.PlotContour(0, 1, 0, cprms)
+		}
+		opt.PlotAddFltFlt(0, 1, sols0, fmtIni, false)
+		opt.PlotAddFltFlt(0, 1, opt.Solutions, fmtFin, true)
+		if best != nil {
+			plt.PlotOne(best.Flt[0], best.Flt[1], "'k*', markersize=6, color='#00b30d', markeredgecolor='white', mew=0.3, label='best', clip_on=0, zorder=20")
+		}
+		plt.Gll("$x_0$", "$x_1$", "leg_out=1, leg_ncol=4, leg_hlen=1.5")
+	default:
+		return fmt.Errorf("plot requires two objectives or at least one float")
+	}
+	plt.SaveD(o.dirout, o.fnkey+o.ext)
+	return
+}
+
+// writeOutput writes final solutions, parameters and log
+//  Note: the convergence history (if ConvHist != "") is written to <key>-hist.csv after Solve or
+//        to <key>-hist.json after RunMany
+func writeOutput(opt *goga.Optimiser, o *options) (err error) {
+	goga.WriteAllValues(o.dirout, o.fnkey, opt)
+	err = opt.Write(filepath.Join(o.dirout, o.fnkey+"-prms.json"))
+	if err != nil {
+		return
+	}
+	io.WriteFileSD(o.dirout, o.fnkey+".log", opt.LogParams())
+	if len(opt.Histories) > 0 {
+		return goga.WriteHistories(o.dirout, o.fnkey+"-hist", opt.Histories)
+	}
+	if opt.History != nil {
+		opt.History.WriteCSV(o.dirout, o.fnkey+"-hist")
+	}
+	return
+}
@@ -0,0 +1,313 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/goga/problems"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Problem holds the data of a problem file
+//  Example (JSON; YAML and TOML are also accepted):
+//   {
+//     "Name"      : "rosen10",
+//     "Benchmark" : "rosenbrock",
+//     "Ndim"      : 10,
+//     "Params"    : { "Nsol" : 60, "Tf" : 300, "Nsamples" : 10 }
+//   }
+//  or, with expressions (see goga.Expr):
+//   {
+//     "Vars"   : ["x", "y"],
+//     "Consts" : { "r" : 2.2 },
+//     "Expr"   : { "f0" : "(x^2 + y - 11)^2 + (x + y^2 - 7)^2", "g0" : "r^2 - (x - 0.05)^2 - (y - 2.5)^2" },
+//     "Params" : { "FltMin" : [0, 0], "FltMax" : [6, 6] }
+//   }
+//  or, with an external command:
+//   {
+//     "Command" : ["python3", "myproblem.py"],
+//     "Nf" : 1, "Ng" : 2, "Nh" : 0,
+//     "Params"  : { "FltMin" : [0, 0], "FltMax" : [6, 6] }
+//   }
+//  Note: bounds are given in Params (FltMin, FltMax, IntMin, IntMax). Benchmarks provide default
+//        bounds if FltMin and FltMax are not given
+type Problem struct {
+	Name      string             // name of problem. default = name of problem file without extension
+	Benchmark string             // name of built-in benchmark; see benchmarks and problems.New
+	Ndim      int                // number of floats of benchmark. 0 means default
+	Command   []string           // external command computing f, g and h; see CommandFunc
+	Nf        int                // number of objective functions of external command or scalable benchmark (DTLZ and WFG)
+	Ng        int                // number of inequality constraints of external command
+	Nh        int                // number of equality constraints of external command
+	Expr      map[string]string  // expressions of f, g and h with keys "f0", "f1", ..., "g0", ..., "h0", ...
+	Vars      []string           // optional names of floats in expressions (aliases of x0, x1, ...)
+	IntVars   []string           // optional names of ints in expressions (aliases of y0, y1, ...)
+	Consts    map[string]float64 // named constants in expressions
+	Params    json.RawMessage    // goga parameters; see goga.Parameters
+
+	// derived
+	fcn     goga.MinProb_t // minimisation problem
+	mutex   sync.Mutex     // protects failure
+	failure error          // first failure of evaluations; see guard
+}
+
+// ReadProblem reads problem file and initialises optimiser
+//  verbose -- show messages during optimisation; overrides Verbose in Params
+//  Note: the optimiser evaluates a guarded variant of the problem function; see Failure
+func ReadProblem(filenamepath string, verbose bool) (prob *Problem, opt *goga.Optimiser, err error) {
+
+	// read file
+	b, err := goga.ReadData(filenamepath)
+	if err != nil {
+		return
+	}
+	prob = new(Problem)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(prob)
+	if err != nil {
+		return nil, nil, chk.Err("cannot unmarshal problem file %q:\n%v", filenamepath, err)
+	}
+	if prob.Name == "" {
+		prob.Name = io.FnKey(filepath.Base(filenamepath))
+	}
+
+	// parameters
+	opt = new(goga.Optimiser)
+	opt.Default()
+	if len(prob.Params) > 0 {
+		err = opt.DecodeStrict(prob.Params)
+		if err != nil {
+			return nil, nil, chk.Err("problem file %q:\n%v", filenamepath, err)
+		}
+	}
+	if opt.RptName == "" {
+		opt.RptName = prob.Name
+	}
+	opt.Verbose = verbose
+
+	// objective
+	err = prob.setFunction(opt)
+	if err != nil {
+		return nil, nil, chk.Err("problem file %q:\n%v", filenamepath, err)
+	}
+
+	// initialise
+	err = opt.InitErr(goga.GenTrialSolutions, nil, prob.guard(prob.fcn), prob.Nf, prob.Ng, prob.Nh)
+	if err == nil {
+		err = prob.Failure()
+	}
+	if err != nil {
+		return nil, nil, chk.Err("problem file %q:\n%v", filenamepath, err)
+	}
+	return
+}
+
+// Failure returns the first failure of the evaluations of the problem function or nil
+func (o *Problem) Failure() error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.failure
+}
+
+// guard returns a variant of fcn that recovers from panics (e.g. of CommandFunc) because they
+// may happen in the goroutines of the optimiser. The first failure is recorded (see Failure)
+// and the failed point is marked as infeasible with infinite objective values
+func (o *Problem) guard(fcn goga.MinProb_t) goga.MinProb_t {
+	return func(f, g, h, x []float64, ξ []int, cpu int) {
+		defer func() {
+			if r := recover(); r != nil {
+				o.mutex.Lock()
+				if o.failure == nil {
+					o.failure = chk.Err("evaluation of problem function failed with x=%v and ξ=%v:\n%v", x, ξ, r)
+				}
+				o.mutex.Unlock()
+				for i := range f {
+					f[i] = math.Inf(1)
+				}
+				for i := range g {
+					g[i] = math.Inf(-1)
+				}
+				for i := range h {
+					h[i] = math.Inf(1)
+				}
+			}
+		}()
+		fcn(f, g, h, x, ξ, cpu)
+	}
+}
+
+// setFunction sets minimisation problem from benchmark, expressions or external command
+func (o *Problem) setFunction(opt *goga.Optimiser) (err error) {
+
+	// check
+	nkinds := 0
+	for _, given := range []bool{o.Benchmark != "", len(o.Command) > 0, len(o.Expr) > 0} {
+		if given {
+			nkinds++
+		}
+	}
+	if nkinds != 1 {
+		return chk.Err("one (and only one) of Benchmark, Expr or Command must be given")
+	}
+
+	// expressions
+	if len(o.Expr) > 0 {
+		f, g, h, err := goga.ExprKeys(o.Expr)
+		if err != nil {
+			return err
+		}
+		sym := &goga.ExprSymbols{
+			Nflt:     utl.Imax(len(opt.FltMin), len(opt.FltSets)),
+			Nint:     utl.Imax(utl.Imax(len(opt.IntMin), len(opt.IntCats)), utl.Imax(opt.BinInt, opt.PermInt)),
+			FltNames: o.Vars,
+			IntNames: o.IntVars,
+			Consts:   o.Consts,
+		}
+		if len(o.Vars) > sym.Nflt || len(o.IntVars) > sym.Nint {
+			return chk.Err("there are more names of variables than variables. bounds must be given in Params")
+		}
+		prob, err := goga.NewExprProb(f, g, h, sym)
+		if err != nil {
+			return err
+		}
+		o.Nf, o.Ng, o.Nh = len(f), len(g), len(h)
+		o.fcn = prob.MinProb
+		return nil
+	}
+
+	// external command
+	if len(o.Command) > 0 {
+		if o.Nf < 1 {
+			return chk.Err("number of objective functions of external command must be given. Nf=%d is invalid", o.Nf)
+		}
+		o.fcn = CommandFunc(o.Command, o.Nf, o.Ng, o.Nh)
+		return
+	}
+
+	// benchmark
+	bench, ok := benchmarks[o.Benchmark]
+	if !ok {
+		return o.setProblem(opt)
+	}
+	ndim := bench.ndim
+	if o.Ndim > 0 {
+		if bench.fixed && o.Ndim != bench.ndim {
+			return chk.Err("benchmark %q requires Ndim=%d. Ndim=%d is invalid", o.Benchmark, bench.ndim, o.Ndim)
+		}
+		ndim = o.Ndim
+	}
+	if len(opt.FltMin) == 0 && len(opt.FltMax) == 0 {
+		opt.FltMin = make([]float64, ndim)
+		opt.FltMax = make([]float64, ndim)
+		for i := 0; i < ndim; i++ {
+			opt.FltMin[i], opt.FltMax[i] = bench.xmin, bench.xmax
+		}
+	}
+	if len(opt.RptFref) == 0 {
+		opt.RptFref = bench.fref
+	}
+	o.Nf, o.Ng, o.Nh = bench.nf, bench.ng, bench.nh
+	o.fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+		bench.fcn(f, g, h, x)
+	}
+	return
+}
+
+// setProblem sets minimisation problem from a benchmark of package problems; see problems.New
+//  Note: Nf is the number of objectives of scalable benchmarks (DTLZ and WFG). 0 means default
+func (o *Problem) setProblem(opt *goga.Optimiser) (err error) {
+	prob, err := problems.New(o.Benchmark, o.Nf, o.Ndim)
+	if err != nil {
+		return chk.Err("benchmark %q is not available. options are: %s or the problems of package problems; e.g. ZDT1, DTLZ2, WFG4, UF1, CF1, G01 or BBOB1:\n%v",
+			o.Benchmark, strings.Join(benchmarkNames(), ", "), err)
+	}
+	if o.Ndim > 0 && len(prob.Xmin) > 0 && len(prob.Xmin) != o.Ndim {
+		return chk.Err("benchmark %q requires Ndim=%d. Ndim=%d is invalid", o.Benchmark, len(prob.Xmin), o.Ndim)
+	}
+	if len(opt.FltMin) == 0 && len(opt.FltMax) == 0 {
+		opt.FltMin = append([]float64(nil), prob.Xmin...)
+		opt.FltMax = append([]float64(nil), prob.Xmax...)
+	}
+	if opt.BinInt == 0 {
+		opt.BinInt = prob.BinInt
+	}
+	if len(opt.RptXref) == 0 {
+		opt.RptXref = append([]float64(nil), prob.Xref...)
+	}
+	if len(opt.RptFref) == 0 {
+		opt.RptFref = append([]float64(nil), prob.Fref...)
+	}
+	if prob.EpsH > 0 {
+		opt.EpsH = prob.EpsH
+	}
+	o.Nf, o.Ng, o.Nh = prob.Nf, prob.Ng, prob.Nh
+	o.fcn = prob.Fcn
+	return
+}
+
+// CommandFunc returns a minimisation function that calls an external command
+//  Protocol: for each evaluation, the command is executed and receives one line in the standard
+//            input with all floats followed by all ints separated by spaces. The command must
+//            write nf+ng+nh numbers (f then g then h) separated by spaces or new lines to the
+//            standard output. Constraints follow the goga convention: g ≥ 0 and h = 0
+func CommandFunc(command []string, nf, ng, nh int) goga.MinProb_t {
+	return func(f, g, h, x []float64, ξ []int, cpu int) {
+
+		// input
+		var inp bytes.Buffer
+		for i, v := range x {
+			if i > 0 {
+				inp.WriteString(" ")
+			}
+			inp.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+		}
+		for i, v := range ξ {
+			if i > 0 || len(x) > 0 {
+				inp.WriteString(" ")
+			}
+			inp.WriteString(strconv.Itoa(v))
+		}
+		inp.WriteString("\n")
+
+		// run
+		var out, stderr bytes.Buffer
+		cmd := exec.Command(command[0], command[1:]...)
+		cmd.Stdin = &inp
+		cmd.Stdout = &out
+		cmd.Stderr = &stderr
+		err := cmd.Run()
+		if err != nil {
+			chk.Panic("external command %v failed:\n%v\n%s", command, err, stderr.String())
+		}
+
+		// output
+		res := strings.Fields(out.String())
+		if len(res) != nf+ng+nh {
+			chk.Panic("external command %v must write %d numbers. %d were written:\n%s", command, nf+ng+nh, len(res), out.String())
+		}
+		vals := make([]float64, len(res))
+		for i, s := range res {
+			vals[i], err = strconv.ParseFloat(s, 64)
+			if err != nil {
+				chk.Panic("external command %v wrote an invalid number %q", command, s)
+			}
+		}
+		copy(f, vals[:nf])
+		copy(g, vals[nf:nf+ng])
+		copy(h, vals[nf+ng:])
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"math"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func init() {
+	io.Verbose = false
+}
+
+func verbose() {
+	io.Verbose = true
+	chk.Verbose = true
+}
+
+func Test_cmd01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cmd01. problem file with benchmark")
+
+	io.WriteFileSD("/tmp/goga", "cmd01.yaml", `
+Benchmark: deb1
+Params:
+  Nsol: 20
+  Ncpu: 2
+  Tf: 50
+  Verbose: false
+`)
+	prob, opt, err := ReadProblem("/tmp/goga/cmd01.yaml", false)
+	if err != nil {
+		tst.Errorf("ReadProblem failed:\n%v\n", err)
+		return
+	}
+	if prob.Name != "cmd01" || opt.RptName != "cmd01" {
+		tst.Errorf("name of problem is incorrect: %q\n", prob.Name)
+	}
+	chk.IntAssert(opt.Nflt, 2)
+	chk.IntAssert(opt.Ng, 2)
+	chk.Vector(tst, "FltMax", 1e-15, opt.FltMax, []float64{6, 6})
+	chk.Vector(tst, "RptFref", 1e-15, opt.RptFref, []float64{13.59085})
+	f, g, h := make([]float64, 1), make([]float64, 2), []float64{}
+	prob.fcn(f, g, h, []float64{2.246826, 2.381865}, nil, 0)
+	chk.Scalar(tst, "f", 1e-4, f[0], 13.59085)
+
+	// errors
+	for i, txt := range []string{
+		`{ "Benchmark" : "sphere", "Ndims" : 3 }`,
+		`{ "Benchmark" : "spheree" }`,
+		`{ "Benchmark" : "deb1", "Ndim" : 3 }`,
+		`{ "Benchmark" : "sphere", "Params" : { "Nsoll" : 10 } }`,
+		`{ "Command" : ["awk", "{print $1}"] }`,
+	} {
+		fn := io.Sf("cmd01-bad%d.json", i)
+		io.WriteFileSD("/tmp/goga", fn, txt)
+		_, _, err = ReadProblem("/tmp/goga/"+fn, false)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("ReadProblem should have failed with %s\n", txt)
+		}
+	}
+}
+
+func Test_cmd02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cmd02. external command")
+
+	// function
+	fcn := CommandFunc([]string{"awk", "{print $1*$1+$2*$2, 1-$1, $3}"}, 1, 1, 1)
+	f, g, h := make([]float64, 1), make([]float64, 1), make([]float64, 1)
+	fcn(f, g, h, []float64{0.5, 2}, []int{3}, 0)
+	chk.Vector(tst, "fgh", 1e-15, []float64{f[0], g[0], h[0]}, []float64{4.25, 0.5, 3})
+
+	// problem file
+	io.WriteFileSD("/tmp/goga", "cmd02.json", `{
+  "Name"    : "circle",
+  "Command" : ["awk", "{printf \"%.17g\\n\", $1*$1+$2*$2}"],
+  "Nf"      : 1,
+  "Params"  : { "Nsol" : 12, "Ncpu" : 1, "Tf" : 10, "FltMin" : [-1, -1], "FltMax" : [1, 1] }
+}`)
+	_, opt, err := ReadProblem("/tmp/goga/cmd02.json", false)
+	if err != nil {
+		tst.Errorf("ReadProblem failed:\n%v\n", err)
+		return
+	}
+	o := options{dirout: "/tmp/goga", fnkey: "cmd02"}
+	err = cmdRun(opt, &o)
+	if err != nil {
+		tst.Errorf("cmdRun failed:\n%v\n", err)
+		return
+	}
+	for _, sol := range opt.Solutions {
+		chk.Scalar(tst, "f", 1e-14, sol.Ova[0], sol.Flt[0]*sol.Flt[0]+sol.Flt[1]*sol.Flt[1])
+	}
+}
+
+func Test_cmd03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cmd03. problem file with expressions")
+
+	io.WriteFileSD("/tmp/goga", "cmd03.yaml", `
+Vars: [x, "y"] # y must be quoted in YAML (boolean otherwise)
+Consts:
+  r: 2.2
+Expr:
+  f0: (x^2 + y - 11)^2 + (x + y^2 - 7)^2
+  g0: r^2 - (x - 0.05)^2 - (y - 2.5)^2
+  g1: x^2 + (y - 2.5)^2 - r^2
+Params:
+  Nsol: 20
+  Ncpu: 2
+  Tf: 50
+  Verbose: false
+  FltMin: [0, 0]
+  FltMax: [6, 6]
+`)
+	prob, opt, err := ReadProblem("/tmp/goga/cmd03.yaml", false)
+	if err != nil {
+		tst.Errorf("ReadProblem failed:\n%v\n", err)
+		return
+	}
+	chk.IntAssert(opt.Nf, 1)
+	chk.IntAssert(opt.Ng, 2)
+	f, g, h := make([]float64, 1), make([]float64, 2), []float64{}
+	prob.fcn(f, g, h, []float64{2.246826, 2.381865}, nil, 0)
+	chk.Scalar(tst, "f", 1e-4, f[0], 13.59085)
+	chk.Scalar(tst, "g1", 1e-15, g[1], 2.246826*2.246826+math.Pow(2.381865-2.5, 2)-2.2*2.2)
+
+	// errors
+	for i, txt := range []string{
+		`{ "Expr" : { "f0" : "x0 + x2" }, "Params" : { "FltMin" : [0, 0], "FltMax" : [1, 1] } }`,
+		`{ "Expr" : { "f1" : "x0" }, "Params" : { "FltMin" : [0], "FltMax" : [1] } }`,
+		`{ "Expr" : { "f0" : "x0" }, "Benchmark" : "sphere" }`,
+		`{ "Expr" : { "f0" : "a" }, "Vars" : ["a", "b"], "Params" : { "FltMin" : [0], "FltMax" : [1] } }`,
+	} {
+		fn := io.Sf("cmd03-bad%d.json", i)
+		io.WriteFileSD("/tmp/goga", fn, txt)
+		_, _, err = ReadProblem("/tmp/goga/"+fn, false)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("ReadProblem should have failed with %s\n", txt)
+		}
+	}
+}
+
+func Test_cmd04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cmd04. failures of external command and verbose flag")
+
+	// verbose flag overrides parameters
+	io.WriteFileSD("/tmp/goga", "cmd04.yaml", `
+Benchmark: sphere
+Ndim: 2
+Params:
+  Nsol: 12
+  Ncpu: 2
+  Verbose: false
+`)
+	_, opt, err := ReadProblem("/tmp/goga/cmd04.yaml", true)
+	if err != nil {
+		tst.Errorf("ReadProblem failed:\n%v\n", err)
+		return
+	}
+	chk.Bool(tst, "verbose", opt.Verbose, true)
+	opt.Verbose = false
+
+	// command always fails: error during initialisation (in goroutines)
+	io.WriteFileSD("/tmp/goga", "cmd04a.json", `{
+  "Command" : ["awk", "{exit 1}"],
+  "Nf"      : 1,
+  "Params"  : { "Nsol" : 12, "Ncpu" : 2, "Tf" : 10, "FltMin" : [-1, -1], "FltMax" : [1, 1] }
+}`)
+	_, _, err = ReadProblem("/tmp/goga/cmd04a.json", false)
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "evaluation of problem function failed") {
+		tst.Errorf("ReadProblem should have failed with failing command\n")
+	}
+
+	// command fails for some points: error returned by run
+	io.WriteFileSD("/tmp/goga", "cmd04b.json", `{
+  "Command" : ["awk", "{ if ($1 > 0.8) exit 1; print $1*$1+$2*$2 }"],
+  "Nf"      : 1,
+  "Params"  : { "Nsol" : 12, "Ncpu" : 2, "Tf" : 20, "FltMin" : [-1, -1], "FltMax" : [1, 1] }
+}`)
+	err = run("run", []string{"-dir", "/tmp/goga", "-key", "cmd04", "/tmp/goga/cmd04b.json"})
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "exit status 1") {
+		tst.Errorf("run should have failed with failing command\n")
+	}
+
+	// failure during evolution (in goroutines)
+	var prob Problem
+	var nfeval int32
+	prob.fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+		if atomic.AddInt32(&nfeval, 1) > 30 {
+			chk.Panic("cannot compute f")
+		}
+		f[0] = x[0]*x[0] + x[1]*x[1]
+	}
+	opt = new(goga.Optimiser)
+	opt.Default()
+	opt.Nsol = 12
+	opt.Ncpu = 2
+	opt.Tf = 20
+	opt.Verbose = false
+	opt.FltMin = []float64{-1, -1}
+	opt.FltMax = []float64{1, 1}
+	opt.Init(goga.GenTrialSolutions, nil, prob.guard(prob.fcn), 1, 0, 0)
+	if prob.Failure() != nil {
+		tst.Errorf("initialisation should not have failed\n")
+		return
+	}
+	opt.Solve()
+	io.Pforan("%v\n", prob.Failure())
+	if prob.Failure() == nil || !strings.Contains(prob.Failure().Error(), "cannot compute f") {
+		tst.Errorf("evolution should have failed\n")
+	}
+}
+
+func Test_cmd05(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cmd05. problem file with benchmark of package problems")
+
+	io.WriteFileSD("/tmp/goga", "cmd05.json", `{
+  "Benchmark" : "zdt1",
+  "Ndim"      : 4,
+  "Params"    : { "Nsol" : 20, "Ncpu" : 2, "Tf" : 10, "Verbose" : false }
+}`)
+	prob, opt, err := ReadProblem("/tmp/goga/cmd05.json", false)
+	if err != nil {
+		tst.Errorf("ReadProblem failed:\n%v\n", err)
+		return
+	}
+	chk.IntAssert(opt.Nflt, 4)
+	chk.IntAssert(opt.Nf, 2)
+	chk.Vector(tst, "FltMax", 1e-15, opt.FltMax, []float64{1, 1, 1, 1})
+	f := make([]float64, 2)
+	prob.fcn(f, nil, nil, []float64{0.25, 0, 0, 0}, nil, 0)
+	chk.Vector(tst, "f", 1e-15, f, []float64{0.25, 0.5})
+
+	// scalable benchmark
+	io.WriteFileSD("/tmp/goga", "cmd05-dtlz.json", `{ "Benchmark" : "DTLZ2", "Nf" : 4, "Params" : { "Nsol" : 20, "Ncpu" : 1, "Tf" : 10 } }`)
+	_, opt, err = ReadProblem("/tmp/goga/cmd05-dtlz.json", false)
+	if err != nil {
+		tst.Errorf("ReadProblem failed:\n%v\n", err)
+		return
+	}
+	chk.IntAssert(opt.Nf, 4)
+
+	// errors
+	for i, txt := range []string{
+		`{ "Benchmark" : "ZDT1", "Ndim" : 1 }`,
+		`{ "Benchmark" : "ZDT9" }`,
+		`{ "Benchmark" : "G01", "Ndim" : 3 }`,
+	} {
+		fn := io.Sf("cmd05-bad%d.json", i)
+		io.WriteFileSD("/tmp/goga", fn, txt)
+		_, _, err = ReadProblem("/tmp/goga/"+fn, false)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("ReadProblem should have failed with %s\n", txt)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// Comparison holds the result of the statistical comparison of the trials of two optimisers;
+// e.g. with different parameters solving the same problem
+type Comparison struct {
+	Key  string  // key of samples. see StatSamples
+	P    float64 // p-value of the rank-sum (or signed-rank) test
+	A12  float64 // Vargha-Delaney effect size: probability that a value of A is greater than a value of B
+	Sign int     // +1 if A is significantly better than B, -1 if A is significantly worse and 0 otherwise
+}
+
+// StatSamples returns the values of all trials computed by RunMany
+//  key -- "f0", "f1", ... for the best objective values (BestOvas); "E" for the errors on the
+//         Pareto front (Multi_err); "F1F0" for the errors on f1(f0) (F1F0_err); "IGD" (Multi_IGD);
+//         or the key of a quality indicator in Multi_ind; e.g. "HV"
+func StatSamples(o *Optimiser, key string) (res []float64) {
+	var src []float64
+	switch key {
+	case "E":
+		src = o.Multi_err
+	case "F1F0":
+		src = o.F1F0_err
+	case "IGD":
+		src = o.Multi_IGD
+	default:
+		if vals, ok := o.Multi_ind[key]; ok {
+			src = vals
+			break
+		}
+		if !strings.HasPrefix(key, "f") {
+			chk.Panic("key of samples %q is invalid. options are \"f0\", \"f1\", ..., \"E\", \"F1F0\", \"IGD\" or quality indicators", key)
+		}
+		i, err := strconv.Atoi(key[1:])
+		if err != nil || i < 0 || i >= len(o.BestOvas) {
+			chk.Panic("key of samples %q is invalid. there are %d objectives", key, len(o.BestOvas))
+		}
+		src = o.BestOvas[i]
+	}
+	return append([]float64(nil), src...)
+}
+
+// LargerIsBetter returns whether larger values of samples with key are better; i.e. only for
+// the hypervolume "HV"
+func LargerIsBetter(key string) bool {
+	return key == "HV"
+}
+
+// CompareOpts compares the trials of two optimisers after RunMany
+//  key    -- key of samples. see StatSamples
+//  alpha  -- significance level; e.g. 0.05
+//  paired -- use the signed-rank test; e.g. if the trials of A and B start with the same seeds.
+//            Otherwise, the rank-sum test is used
+func CompareOpts(A, B *Optimiser, key string, alpha float64, paired bool) (res Comparison) {
+	x, y := StatSamples(A, key), StatSamples(B, key)
+	res.Key = key
+	if len(x) == 0 || len(y) == 0 {
+		res.P, res.A12 = 1, 0.5
+		return
+	}
+	if paired {
+		_, res.P = SignedRank(x, y)
+	} else {
+		_, res.P = RankSum(x, y)
+	}
+	res.A12 = A12(x, y)
+	if res.P < alpha && res.A12 != 0.5 {
+		res.Sign = 1
+		if (res.A12 > 0.5) != LargerIsBetter(key) {
+			res.Sign = -1
+		}
+	}
+	return
+}
+
+// CompareMany compares the trials of many optimisers after RunMany with the Kruskal-Wallis test
+// and pairwise rank-sum tests with the Holm correction
+//  key -- key of samples. see StatSamples
+//  p   -- p-value of Kruskal-Wallis test
+//  P   -- [nopts][nopts] adjusted p-values of pairwise comparisons
+func CompareMany(opts []*Optimiser, key string) (p float64, P [][]float64) {
+	samples := make([][]float64, len(opts))
+	for i, opt := range opts {
+		samples[i] = StatSamples(opt, key)
+	}
+	_, p = KruskalWallis(samples)
+	var pairs []float64
+	for i := 0; i < len(opts); i++ {
+		for j := i + 1; j < len(opts); j++ {
+			_, pij := RankSum(samples[i], samples[j])
+			pairs = append(pairs, pij)
+		}
+	}
+	P = pairMatrix(len(opts), Holm(pairs))
+	return
+}
+
+// RankConfigs compares configurations (e.g. sets of parameters) over many problems with the
+// Friedman test on the average values of trials
+//  configs -- [nconfigs][nproblems] optimisers after RunMany
+//  key     -- key of samples. see StatSamples
+//  posthoc -- post-hoc test: "nemenyi" or "holm"
+//  R       -- [nconfigs] average ranks; 1 is the best rank
+//  p       -- p-value of Friedman test
+//  P       -- [nconfigs][nconfigs] p-values of post-hoc tests
+func RankConfigs(configs [][]*Optimiser, key, posthoc string) (R []float64, p float64, P [][]float64) {
+	nconfigs := len(configs)
+	if nconfigs < 2 {
+		chk.Panic("at least 2 configurations are required. %d is invalid", nconfigs)
+	}
+	nprobs := len(configs[0])
+	data := make([][]float64, nprobs)
+	for j := 0; j < nprobs; j++ {
+		data[j] = make([]float64, nconfigs)
+		for i := 0; i < nconfigs; i++ {
+			if len(configs[i]) != nprobs {
+				chk.Panic("all configurations must have the same number of problems. %d != %d", len(configs[i]), nprobs)
+			}
+			samples := StatSamples(configs[i][j], key)
+			if len(samples) == 0 {
+				chk.Panic("there are no samples %q of problem %q for configuration %d", key, configs[i][j].RptName, i)
+			}
+			for _, v := range samples {
+				data[j][i] += v / float64(len(samples))
+			}
+			if LargerIsBetter(key) {
+				data[j][i] = -data[j][i]
+			}
+		}
+	}
+	_, p, R = Friedman(data)
+	switch posthoc {
+	case "nemenyi":
+		P = PostHocNemenyi(data)
+	case "holm":
+		P = PostHocHolm(data)
+	default:
+		chk.Panic("post-hoc test %q is not available. options are \"nemenyi\" or \"holm\"", posthoc)
+	}
+	return
+}
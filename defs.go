@@ -18,6 +18,12 @@ type ObjFunc_t func(sol *Solution, cpu int)
 // MinProb_t defines objective functon for specialised minimisation problem
 type MinProb_t func(f, g, h, x []float64, ξ []int, cpu int)
 
+// MinProbGrad_t defines the gradients of the functions of the specialised minimisation problem
+//  dfdx -- [nf][nflt] derivatives of f with respect to xFlt
+//  dgdx -- [ng][nflt] derivatives of g with respect to xFlt
+//  dhdx -- [nh][nflt] derivatives of h with respect to xFlt
+type MinProbGrad_t func(dfdx, dgdx, dhdx [][]float64, x []float64, ξ []int, cpu int)
+
 // CxInt_t defines crossover function for ints
 type CxInt_t func(a, b, A, B []int, prms *Parameters)
 
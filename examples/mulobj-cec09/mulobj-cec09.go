@@ -50,6 +50,7 @@ func solve_problem(problem string) (opt *goga.Optimiser) {
 
 	// load reference values
 	opt.Multi_fStar = cec09.PFdata(problem)
+	opt.Multi_indKeys = []string{"IGD+", "EPS+", "HV"}
 
 	// number of trial solutions
 	opt.Nsol = 600
@@ -69,6 +70,9 @@ func solve_problem(problem string) (opt *goga.Optimiser) {
 	// solve
 	opt.RunMany("", "")
 	goga.StatMulti(opt, true)
+	for _, key := range opt.Multi_indKeys {
+		goga.StatIndicator(opt, key, true)
+	}
 
 	// check
 	goga.CheckFront0(opt, true)
@@ -0,0 +1,49 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import (
+	"github.com/cpmech/goga"
+	"github.com/cpmech/goga/problems"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+)
+
+// runs solves all BBOB functions with a few instances and returns the loggers
+func runs(dim, ninstances int, dec float64) (loggers []*problems.Logger) {
+	for k := 1; k <= 24; k++ {
+		for instance := 1; instance <= ninstances; instance++ {
+			var opt goga.Optimiser
+			opt.Default()
+			opt.Nsol = 10 * dim
+			opt.Tf = 500
+			opt.Ncpu = 4
+			opt.DEC = dec
+			opt.Verbose = false
+			prob := problems.BBOB(k, dim, instance)
+			logger := problems.NewLogger(prob.Fref[0], nil)
+			prob.Fcn = logger.Wrap(prob.Fcn)
+			prob.Init(&opt)
+			opt.Solve()
+			logger.Write("/tmp/goga", io.Sf("bbob-%s-d%d-i%d-dec%g", prob.Name, dim, instance, dec))
+			loggers = append(loggers, logger)
+		}
+	}
+	return
+}
+
+func main() {
+	dim, ninstances := 5, 5
+	plt.SetForEps(0.75, 400)
+	styles := []string{"'b-'", "'r-'", "'g-'"}
+	for i, dec := range []float64{0.2, 0.5, 0.9} {
+		io.Pf("solving BBOB functions with DEC = %g\n", dec)
+		loggers := runs(dim, ninstances, dec)
+		problems.PlotECDF(loggers, nil, dim, io.Sf("%s, label='DEC=%g'", styles[i], dec))
+	}
+	plt.SaveD("/tmp/goga", io.Sf("bbob-ecdf-d%d.eps", dim))
+}
@@ -0,0 +1,32 @@
+// Copyright 2012 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+package main
+
+import (
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func main() {
+	P := utl.IntRange2(1, 25)
+	opts := make([]*goga.Optimiser, len(P))
+	for i, problem := range P {
+		opts[i] = getfcn(problem)
+		opts[i].Verbose = false
+		io.Pf("%s: running %d samples\n", opts[i].RptName, opts[i].Nsamples)
+		opts[i].RunMany("", "")
+		goga.StatF(opts[i], 0, true)
+	}
+	io.Pf("\n-------------------------- generating report --------------------------\n")
+	rpt := goga.NewTexReport(opts)
+	rpt.NRowPerTab = 12
+	rpt.Type = 4
+	rpt.Title = "CEC 2006 constrained single objective problems"
+	rpt.Fnkey = "oneobj-cec06"
+	rpt.Generate()
+}
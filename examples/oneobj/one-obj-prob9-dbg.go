@@ -30,6 +30,7 @@ func main() {
 	//opt.GenType = "rnd"
 	opt.NormFlt = false
 	opt.UseMesh = true
+	opt.GenAll = true
 	opt.Nbry = 3
 
 	// define problem
@@ -0,0 +1,548 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// ExprSymbols holds the symbols available to expressions
+//  Note: floats are always available as x0, x1, ... and ints as y0, y1, ... The constants pi and e
+//        are also always available
+type ExprSymbols struct {
+	Nflt     int                // number of floats
+	Nint     int                // number of ints
+	FltNames []string           // optional names of floats; e.g. ["a", "b"] => a ≡ x0 and b ≡ x1
+	IntNames []string           // optional names of ints; e.g. ["n"] => n ≡ y0
+	Consts   map[string]float64 // named constants
+}
+
+// Expr holds a mathematical expression compiled into an evaluator
+//  Syntax:
+//   operators  -- + - * / ^ (or **) with the usual precedence; ^ is right-associative
+//   functions  -- sin cos tan asin acos atan sinh cosh tanh asinh acosh atanh exp log log10 log2
+//                 sqrt cbrt abs floor ceil round sign; atan2 pow hypot mod; min max (≥ 1 args)
+//   numbers    -- e.g. 2, 0.5, 1e-3
+//   names      -- variables and constants; see ExprSymbols
+//  Note: subexpressions with constants only are evaluated at compile time
+type Expr struct {
+	Src  string // source
+	node *exprNode
+}
+
+// Eval evaluates expression with floats x and ints y
+func (o *Expr) Eval(x []float64, y []int) float64 {
+	return o.node.fcn(x, y)
+}
+
+// CompileExpr compiles expression
+func CompileExpr(src string, sym *ExprSymbols) (o *Expr, err error) {
+	if sym == nil {
+		sym = new(ExprSymbols)
+	}
+	p := exprParser{src: src, sym: sym}
+	err = p.tokenize()
+	if err == nil {
+		var node *exprNode
+		node, err = p.parseSum()
+		if err == nil && p.peek().kind != tokEnd {
+			err = p.unexpected(p.peek())
+		}
+		if err == nil {
+			return &Expr{Src: src, node: node}, nil
+		}
+	}
+	return nil, chk.Err("cannot compile expression %q:\n%v", src, err)
+}
+
+// ExprProb holds a minimisation problem with f, g and h defined by expressions
+type ExprProb struct {
+	F []*Expr // objective functions
+	G []*Expr // inequality constraints: g ≥ 0
+	H []*Expr // equality constraints: h = 0
+}
+
+// NewExprProb compiles expressions of f, g and h
+//  Note: all errors are reported at once
+func NewExprProb(f, g, h []string, sym *ExprSymbols) (o *ExprProb, err error) {
+	o = new(ExprProb)
+	var msgs []string
+	compile := func(key string, srcs []string) (res []*Expr) {
+		res = make([]*Expr, len(srcs))
+		for i, src := range srcs {
+			e, err := CompileExpr(src, sym)
+			if err != nil {
+				msgs = append(msgs, io.Sf("%s%d: %v", key, i, err))
+			}
+			res[i] = e
+		}
+		return
+	}
+	o.F = compile("f", f)
+	o.G = compile("g", g)
+	o.H = compile("h", h)
+	if len(o.F) < 1 {
+		msgs = append(msgs, "at least one objective function must be given")
+	}
+	if len(msgs) > 0 {
+		return nil, chk.Err("%s", strings.Join(msgs, "\n"))
+	}
+	return
+}
+
+// MinProb implements MinProb_t
+func (o *ExprProb) MinProb(f, g, h, x []float64, y []int, cpu int) {
+	for i, e := range o.F {
+		f[i] = e.node.fcn(x, y)
+	}
+	for i, e := range o.G {
+		g[i] = e.node.fcn(x, y)
+	}
+	for i, e := range o.H {
+		h[i] = e.node.fcn(x, y)
+	}
+}
+
+// ExprKeys splits expressions given by keys such as "f0", "f1", "g0", "h0" into f, g and h lists
+//  Note: indices of each kind must be sequential and start at zero
+func ExprKeys(exprs map[string]string) (f, g, h []string, err error) {
+	idx := map[byte]map[int]string{'f': {}, 'g': {}, 'h': {}}
+	for key, src := range exprs {
+		if len(key) < 2 || idx[key[0]] == nil {
+			return nil, nil, nil, chk.Err("key of expression %q is invalid. keys must be f0, f1, ..., g0, g1, ... or h0, h1, ...", key)
+		}
+		i, e := strconv.Atoi(key[1:])
+		if e != nil || i < 0 || key[1:] != strconv.Itoa(i) {
+			return nil, nil, nil, chk.Err("key of expression %q is invalid. keys must be f0, f1, ..., g0, g1, ... or h0, h1, ...", key)
+		}
+		idx[key[0]][i] = src
+	}
+	list := func(kind byte) (res []string, err error) {
+		res = make([]string, len(idx[kind]))
+		for i := range res {
+			src, ok := idx[kind][i]
+			if !ok {
+				return nil, chk.Err("expression %c%d is missing. indices must be sequential", kind, i)
+			}
+			res[i] = src
+		}
+		return
+	}
+	if f, err = list('f'); err != nil {
+		return
+	}
+	if g, err = list('g'); err != nil {
+		return
+	}
+	h, err = list('h')
+	return
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// exprNode holds a node of a compiled expression
+type exprNode struct {
+	fcn   func(x []float64, y []int) float64 // evaluator
+	konst bool                               // node is constant
+	val   float64                            // value of constant node
+}
+
+// exprConst returns a constant node
+func exprConst(v float64) *exprNode {
+	return &exprNode{fcn: func(x []float64, y []int) float64 { return v }, konst: true, val: v}
+}
+
+// exprFunc holds a function available to expressions
+type exprFunc struct {
+	f1 func(a float64) float64    // function with one argument
+	f2 func(a, b float64) float64 // function with two arguments or reduction of variadic function
+	va bool                       // variadic (≥ 1 arguments) using f2 as reduction
+}
+
+// exprFuncs holds all functions available to expressions
+var exprFuncs = map[string]exprFunc{
+	"sin": {f1: math.Sin}, "cos": {f1: math.Cos}, "tan": {f1: math.Tan},
+	"asin": {f1: math.Asin}, "acos": {f1: math.Acos}, "atan": {f1: math.Atan},
+	"sinh": {f1: math.Sinh}, "cosh": {f1: math.Cosh}, "tanh": {f1: math.Tanh},
+	"asinh": {f1: math.Asinh}, "acosh": {f1: math.Acosh}, "atanh": {f1: math.Atanh},
+	"exp": {f1: math.Exp}, "log": {f1: math.Log}, "log10": {f1: math.Log10}, "log2": {f1: math.Log2},
+	"sqrt": {f1: math.Sqrt}, "cbrt": {f1: math.Cbrt}, "abs": {f1: math.Abs},
+	"floor": {f1: math.Floor}, "ceil": {f1: math.Ceil}, "round": {f1: math.Round},
+	"sign": {f1: func(a float64) float64 {
+		switch {
+		case a > 0:
+			return 1
+		case a < 0:
+			return -1
+		}
+		return 0
+	}},
+	"atan2": {f2: math.Atan2}, "pow": {f2: math.Pow}, "hypot": {f2: math.Hypot}, "mod": {f2: math.Mod},
+	"min": {f2: math.Min, va: true}, "max": {f2: math.Max, va: true},
+}
+
+// token kinds
+const (
+	tokEnd = iota // end of expression
+	tokNum        // number
+	tokId         // identifier
+	tokOp         // operator or parenthesis or comma
+)
+
+// exprToken holds a token of expression
+type exprToken struct {
+	kind int     // kind of token
+	text string  // text of token
+	pos  int     // position in source (starting at 0)
+	num  float64 // value of number
+}
+
+// exprParser implements a recursive-descent parser of expressions
+type exprParser struct {
+	src    string       // source
+	sym    *ExprSymbols // symbols
+	tokens []exprToken  // all tokens
+	idx    int          // index of current token
+}
+
+// tokenize splits source into tokens
+func (o *exprParser) tokenize() error {
+	s := []rune(o.src)
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c) || (c == '.' && i+1 < len(s) && unicode.IsDigit(s[i+1])):
+			j := i
+			for j < len(s) && (unicode.IsDigit(s[j]) || s[j] == '.') {
+				j++
+			}
+			if j < len(s) && (s[j] == 'e' || s[j] == 'E') {
+				k := j + 1
+				if k < len(s) && (s[k] == '+' || s[k] == '-') {
+					k++
+				}
+				if k < len(s) && unicode.IsDigit(s[k]) {
+					for j = k; j < len(s) && unicode.IsDigit(s[j]); j++ {
+					}
+				}
+			}
+			v, err := strconv.ParseFloat(string(s[i:j]), 64)
+			if err != nil {
+				return chk.Err("invalid number %q at position %d", string(s[i:j]), i+1)
+			}
+			o.tokens = append(o.tokens, exprToken{kind: tokNum, text: string(s[i:j]), pos: i, num: v})
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(s) && (unicode.IsLetter(s[j]) || unicode.IsDigit(s[j]) || s[j] == '_') {
+				j++
+			}
+			o.tokens = append(o.tokens, exprToken{kind: tokId, text: string(s[i:j]), pos: i})
+			i = j
+		case c == '*' && i+1 < len(s) && s[i+1] == '*':
+			o.tokens = append(o.tokens, exprToken{kind: tokOp, text: "^", pos: i})
+			i += 2
+		case strings.ContainsRune("+-*/^(),", c):
+			o.tokens = append(o.tokens, exprToken{kind: tokOp, text: string(c), pos: i})
+			i++
+		default:
+			return chk.Err("invalid character %q at position %d", c, i+1)
+		}
+	}
+	o.tokens = append(o.tokens, exprToken{kind: tokEnd, pos: len(s)})
+	return nil
+}
+
+// peek returns current token
+func (o *exprParser) peek() exprToken {
+	return o.tokens[o.idx]
+}
+
+// next returns current token and advances
+func (o *exprParser) next() exprToken {
+	t := o.tokens[o.idx]
+	if t.kind != tokEnd {
+		o.idx++
+	}
+	return t
+}
+
+// isOp tells whether current token is operator op
+func (o *exprParser) isOp(op string) bool {
+	t := o.tokens[o.idx]
+	return t.kind == tokOp && t.text == op
+}
+
+// unexpected returns error about unexpected token
+func (o *exprParser) unexpected(t exprToken) error {
+	if t.kind == tokEnd {
+		return chk.Err("unexpected end of expression")
+	}
+	return chk.Err("unexpected %q at position %d", t.text, t.pos+1)
+}
+
+// parseSum parses: sum := product (('+'|'-') product)*
+func (o *exprParser) parseSum() (a *exprNode, err error) {
+	a, err = o.parseProduct()
+	for err == nil && (o.isOp("+") || o.isOp("-")) {
+		op := o.next().text
+		var b *exprNode
+		b, err = o.parseProduct()
+		if err == nil {
+			a = exprBinary(op, a, b)
+		}
+	}
+	return
+}
+
+// parseProduct parses: product := unary (('*'|'/') unary)*
+func (o *exprParser) parseProduct() (a *exprNode, err error) {
+	a, err = o.parseUnary()
+	for err == nil && (o.isOp("*") || o.isOp("/")) {
+		op := o.next().text
+		var b *exprNode
+		b, err = o.parseUnary()
+		if err == nil {
+			a = exprBinary(op, a, b)
+		}
+	}
+	return
+}
+
+// parseUnary parses: unary := ('-'|'+') unary | power
+func (o *exprParser) parseUnary() (a *exprNode, err error) {
+	if o.isOp("-") || o.isOp("+") {
+		op := o.next().text
+		a, err = o.parseUnary()
+		if err != nil || op == "+" {
+			return
+		}
+		return exprBinary("-", exprConst(0), a), nil
+	}
+	return o.parsePower()
+}
+
+// parsePower parses: power := primary ('^' unary)?
+func (o *exprParser) parsePower() (a *exprNode, err error) {
+	a, err = o.parsePrimary()
+	if err == nil && o.isOp("^") {
+		o.next()
+		var b *exprNode
+		b, err = o.parseUnary()
+		if err == nil {
+			a = exprBinary("^", a, b)
+		}
+	}
+	return
+}
+
+// parsePrimary parses: primary := number | name | name '(' args ')' | '(' sum ')'
+func (o *exprParser) parsePrimary() (a *exprNode, err error) {
+	t := o.next()
+	switch {
+	case t.kind == tokNum:
+		return exprConst(t.num), nil
+	case t.kind == tokId && o.isOp("("):
+		return o.parseCall(t)
+	case t.kind == tokId:
+		return o.symbol(t)
+	case t.kind == tokOp && t.text == "(":
+		a, err = o.parseSum()
+		if err != nil {
+			return
+		}
+		if !o.isOp(")") {
+			return nil, chk.Err("missing ')' at position %d", o.peek().pos+1)
+		}
+		o.next()
+		return
+	}
+	return nil, o.unexpected(t)
+}
+
+// parseCall parses function call
+func (o *exprParser) parseCall(name exprToken) (a *exprNode, err error) {
+	fcn, ok := exprFuncs[name.text]
+	if !ok {
+		return nil, chk.Err("unknown function %q at position %d", name.text, name.pos+1)
+	}
+	o.next() // (
+	var args []*exprNode
+	for !o.isOp(")") {
+		if len(args) > 0 {
+			if !o.isOp(",") {
+				return nil, chk.Err("missing ',' or ')' at position %d", o.peek().pos+1)
+			}
+			o.next()
+		}
+		var arg *exprNode
+		arg, err = o.parseSum()
+		if err != nil {
+			return
+		}
+		args = append(args, arg)
+	}
+	o.next() // )
+	nargs := 1
+	if fcn.f1 == nil {
+		nargs = 2
+	}
+	switch {
+	case fcn.va && len(args) < 1:
+		return nil, chk.Err("function %q requires at least one argument", name.text)
+	case !fcn.va && len(args) != nargs:
+		return nil, chk.Err("function %q requires %d argument(s). %d were given", name.text, nargs, len(args))
+	}
+	if fcn.f1 != nil {
+		return exprUnary(fcn.f1, args[0]), nil
+	}
+	a = args[0]
+	if !fcn.va {
+		return exprApply2(fcn.f2, a, args[1]), nil
+	}
+	for _, b := range args[1:] {
+		a = exprApply2(fcn.f2, a, b)
+	}
+	return
+}
+
+// symbol returns node corresponding to variable or constant
+func (o *exprParser) symbol(t exprToken) (*exprNode, error) {
+	name := t.text
+	for i, n := range o.sym.FltNames {
+		if n == name {
+			return exprFlt(i), nil
+		}
+	}
+	for i, n := range o.sym.IntNames {
+		if n == name {
+			return exprInt(i), nil
+		}
+	}
+	if v, ok := o.sym.Consts[name]; ok {
+		return exprConst(v), nil
+	}
+	switch name {
+	case "pi":
+		return exprConst(math.Pi), nil
+	case "e":
+		return exprConst(math.E), nil
+	}
+	if len(name) > 1 && (name[0] == 'x' || name[0] == 'y') {
+		if i, err := strconv.Atoi(name[1:]); err == nil && name[1:] == strconv.Itoa(i) {
+			if name[0] == 'x' && i < o.sym.Nflt {
+				return exprFlt(i), nil
+			}
+			if name[0] == 'y' && i < o.sym.Nint {
+				return exprInt(i), nil
+			}
+		}
+	}
+	msg := io.Sf("unknown variable %q at position %d", name, t.pos+1)
+	if similar := closestKey(name, o.known()); similar != "" {
+		msg += io.Sf(" (did you mean %q?)", similar)
+	}
+	return nil, chk.Err("%s", msg)
+}
+
+// known returns all known symbols (lowercase mapped to name) used in suggestions
+func (o *exprParser) known() (res map[string]string) {
+	var names []string
+	for i := 0; i < o.sym.Nflt; i++ {
+		names = append(names, io.Sf("x%d", i))
+	}
+	for i := 0; i < o.sym.Nint; i++ {
+		names = append(names, io.Sf("y%d", i))
+	}
+	names = append(names, o.sym.FltNames...)
+	names = append(names, o.sym.IntNames...)
+	for name := range o.sym.Consts {
+		names = append(names, name)
+	}
+	names = append(names, "pi")
+	sort.Strings(names)
+	res = make(map[string]string)
+	for _, name := range names {
+		res[strings.ToLower(name)] = name
+	}
+	return
+}
+
+// exprFlt returns node with float variable
+func exprFlt(i int) *exprNode {
+	return &exprNode{fcn: func(x []float64, y []int) float64 { return x[i] }}
+}
+
+// exprInt returns node with int variable
+func exprInt(i int) *exprNode {
+	return &exprNode{fcn: func(x []float64, y []int) float64 { return float64(y[i]) }}
+}
+
+// exprUnary returns node applying f to a
+func exprUnary(f func(float64) float64, a *exprNode) *exprNode {
+	if a.konst {
+		return exprConst(f(a.val))
+	}
+	fa := a.fcn
+	return &exprNode{fcn: func(x []float64, y []int) float64 { return f(fa(x, y)) }}
+}
+
+// exprApply2 returns node applying f to a and b
+func exprApply2(f func(a, b float64) float64, a, b *exprNode) *exprNode {
+	if a.konst && b.konst {
+		return exprConst(f(a.val, b.val))
+	}
+	fa, fb := a.fcn, b.fcn
+	return &exprNode{fcn: func(x []float64, y []int) float64 { return f(fa(x, y), fb(x, y)) }}
+}
+
+// exprBinary returns node with binary operation
+func exprBinary(op string, a, b *exprNode) *exprNode {
+	if a.konst && b.konst {
+		switch op {
+		case "+":
+			return exprConst(a.val + b.val)
+		case "-":
+			return exprConst(a.val - b.val)
+		case "*":
+			return exprConst(a.val * b.val)
+		case "/":
+			return exprConst(a.val / b.val)
+		}
+		return exprConst(math.Pow(a.val, b.val))
+	}
+	fa, fb := a.fcn, b.fcn
+	var f func(x []float64, y []int) float64
+	switch op {
+	case "+":
+		f = func(x []float64, y []int) float64 { return fa(x, y) + fb(x, y) }
+	case "-":
+		if a.konst && a.val == 0 {
+			f = func(x []float64, y []int) float64 { return -fb(x, y) }
+		} else {
+			f = func(x []float64, y []int) float64 { return fa(x, y) - fb(x, y) }
+		}
+	case "*":
+		f = func(x []float64, y []int) float64 { return fa(x, y) * fb(x, y) }
+	case "/":
+		f = func(x []float64, y []int) float64 { return fa(x, y) / fb(x, y) }
+	default:
+		if b.konst && b.val == 2 {
+			f = func(x []float64, y []int) float64 { v := fa(x, y); return v * v }
+		} else {
+			f = func(x []float64, y []int) float64 { return math.Pow(fa(x, y), fb(x, y)) }
+		}
+	}
+	return &exprNode{fcn: f}
+}
@@ -5,6 +5,8 @@
 package goga
 
 import (
+	"math"
+
 	"github.com/cpmech/gosl/chk"
 	"github.com/cpmech/gosl/rnd"
 )
@@ -12,6 +14,12 @@ import (
 // GenTrialSolutions generates (initial) trial solutions
 func GenTrialSolutions(sols []*Solution, prms *Parameters) {
 
+	// variable-length chromosomes
+	if prms.VarLen {
+		GenVarLen(sols, prms)
+		return
+	}
+
 	// floats
 	n := len(sols) // cannot use Nsol here because subsets of Solutions may be provided; e.g. parallel code
 	if prms.Nflt > 0 {
@@ -25,6 +33,13 @@ func GenTrialSolutions(sols []*Solution, prms *Parameters) {
 					sols[i].Flt[j] = prms.FltMin[j] + float64(K[j][i]-1)*prms.DelFlt[j]/float64(n-1)
 				}
 			}
+		case "maximin":
+			K := LatinMaximin(prms.Nflt, n, prms.LatinNit)
+			for i := 0; i < n; i++ {
+				for j := 0; j < prms.Nflt; j++ {
+					sols[i].Flt[j] = prms.FltMin[j] + float64(K[j][i]-1)*prms.DelFlt[j]/float64(n-1)
+				}
+			}
 		case "halton":
 			H := rnd.HaltonPoints(prms.Nflt, n)
 			for i := 0; i < n; i++ {
@@ -32,7 +47,14 @@ func GenTrialSolutions(sols []*Solution, prms *Parameters) {
 					sols[i].Flt[j] = prms.FltMin[j] + H[j][i]*prms.DelFlt[j]
 				}
 			}
-		default:
+		case "sobol":
+			S := SobolPoints(prms.Nflt, n, true)
+			for i := 0; i < n; i++ {
+				for j := 0; j < prms.Nflt; j++ {
+					sols[i].Flt[j] = prms.FltMin[j] + S[j][i]*prms.DelFlt[j]
+				}
+			}
+		default: // "rnd" and "opposition"
 			for i := 0; i < n; i++ {
 				for j := 0; j < prms.Nflt; j++ {
 					sols[i].Flt[j] = rnd.Float64(prms.FltMin[j], prms.FltMax[j])
@@ -41,6 +63,9 @@ func GenTrialSolutions(sols []*Solution, prms *Parameters) {
 		}
 
 		// extra points
+		for i := 0; i < n; i++ {
+			sols[i].Fixed = false // boundary solutions may have been moved by sorting; e.g. in RunMany
+		}
 		if prms.UseMesh {
 			initX := func(isol int) {
 				for k := 0; k < prms.Nflt; k++ {
@@ -111,10 +136,17 @@ func GenTrialSolutions(sols []*Solution, prms *Parameters) {
 			}
 			chk.IntAssert(isol, prms.Nsol)
 		}
+
+		// discrete floats
+		if prms.Ndis > 0 {
+			for i := 0; i < n; i++ {
+				prms.SnapFlt(sols[i].Flt)
+			}
+		}
 	}
 
 	// skip if there are no ints
-	if prms.Nint < 2 {
+	if prms.Nint < 1 {
 		return
 	}
 
@@ -128,15 +160,74 @@ func GenTrialSolutions(sols []*Solution, prms *Parameters) {
 					sols[i].Int[j] = 0
 				}
 			}
+			if prms.FltBits > 0 {
+				prms.DecodeFlt(sols[i].Flt, sols[i].Int)
+			}
+		}
+		return
+	}
+
+	// permutations
+	if prms.PermInt > 0 {
+		for i := 0; i < n; i++ {
+			GenPerm(sols[i].Int)
 		}
 		return
 	}
 
 	// general integers
-	L := rnd.LatinIHS(prms.Nint, n, prms.LatinDup)
-	for i := 0; i < n; i++ {
-		for j := 0; j < prms.Nint; j++ {
-			sols[i].Int[j] = prms.IntMin[j] + (L[j][i]-1)*prms.DelInt[j]/(n-1)
+	switch prms.GenType {
+	case "latin", "maximin":
+		var L [][]int
+		if prms.GenType == "latin" {
+			L = rnd.LatinIHS(prms.Nint, n, prms.LatinDup)
+		} else {
+			L = LatinMaximin(prms.Nint, n, prms.LatinNit)
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < prms.Nint; j++ {
+				if prms.IsCat(j) {
+					sols[i].Int[j] = rnd.Int(prms.IntMin[j], prms.IntMax[j]) // unordered: no stratification
+					continue
+				}
+				sols[i].Int[j] = prms.IntMin[j] + (L[j][i]-1)*prms.DelInt[j]/(n-1)
+			}
+		}
+	case "halton", "sobol":
+		var U [][]float64
+		if prms.GenType == "halton" {
+			U = rnd.HaltonPoints(prms.Nint, n)
+		} else {
+			U = SobolPoints(prms.Nint, n, true)
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < prms.Nint; j++ {
+				y := prms.IntMin[j] + int(math.Floor(U[j][i]*float64(prms.DelInt[j]+1)))
+				sols[i].Int[j] = prms.EnforceIntRange(j, y)
+			}
+		}
+	default: // "rnd" and "opposition"
+		for i := 0; i < n; i++ {
+			for j := 0; j < prms.Nint; j++ {
+				sols[i].Int[j] = rnd.Int(prms.IntMin[j], prms.IntMax[j])
+			}
 		}
 	}
 }
+
+// GenPerm generates a random permutation of {0,1,...,len(a)-1} into a
+func GenPerm(a []int) {
+	for i := 0; i < len(a); i++ {
+		a[i] = i
+	}
+	GenPermShuffle(a)
+}
+
+// GenPermShuffle randomly shuffles a
+//  Note: using Fisher-Yates (Durstenfeld) shuffle
+func GenPermShuffle(a []int) {
+	for i := len(a) - 1; i > 0; i-- {
+		j := rnd.Int(0, i)
+		a[i], a[j] = a[j], a[i]
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cpmech/goga/indicators"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+)
+
+// History holds the convergence history of one run (trial) recorded by Solve if ConvHist != ""
+//  Note: the statistics of objective values are computed with the feasible solutions or with all
+//        solutions if none is feasible. The quality indicators are computed with the front of
+//        feasible solutions; or are set to the worst values (INF or 0 for HV) if none is feasible
+type History struct {
+	Times     []int                // times when data were recorded
+	Nfeval    []int                // number of function evaluations at Times
+	Fbest     [][]float64          // [nova][ntimes] best (minimum) objective values
+	Fmean     [][]float64          // [nova][ntimes] mean objective values
+	Fworst    [][]float64          // [nova][ntimes] worst (maximum) objective values
+	Feasible  []float64            // [ntimes] ratio of feasible solutions
+	Nfronts   []int                // [ntimes] number of non-dominated fronts
+	Front0    []int                // [ntimes] size of front 0
+	Diversity []float64            // [ntimes] average distance to centroid of variables normalised by their ranges
+	IndKeys   []string             // keys of quality indicators; i.e. Multi_indKeys
+	Ind       map[string][]float64 // [key][ntimes] quality indicators computed with Multi_fStar. INF (0 for HV) if no solution is feasible
+}
+
+// Keys returns the keys of all series in history; e.g. for WriteCSV or Series
+//  Note: the keys are "time", "nfeval", "f0best", "f0mean", "f0worst", "f1best", ...,
+//        "feasible", "nfronts", "front0", "diversity" and the keys of quality indicators
+func (o *History) Keys() (keys []string) {
+	keys = []string{"time", "nfeval"}
+	for i := range o.Fbest {
+		keys = append(keys, io.Sf("f%dbest", i), io.Sf("f%dmean", i), io.Sf("f%dworst", i))
+	}
+	keys = append(keys, "feasible", "nfronts", "front0", "diversity")
+	return append(keys, o.IndKeys...)
+}
+
+// Series returns the recorded values corresponding to key. see Keys
+func (o *History) Series(key string) (res []float64) {
+	ints := func(v []int) []float64 {
+		res := make([]float64, len(v))
+		for i, x := range v {
+			res[i] = float64(x)
+		}
+		return res
+	}
+	switch key {
+	case "time":
+		return ints(o.Times)
+	case "nfeval":
+		return ints(o.Nfeval)
+	case "feasible":
+		return append(res, o.Feasible...)
+	case "nfronts":
+		return ints(o.Nfronts)
+	case "front0":
+		return ints(o.Front0)
+	case "diversity":
+		return append(res, o.Diversity...)
+	}
+	if vals, ok := o.Ind[key]; ok {
+		return append(res, vals...)
+	}
+	for _, kind := range []string{"best", "mean", "worst"} {
+		if !strings.HasPrefix(key, "f") || !strings.HasSuffix(key, kind) {
+			continue
+		}
+		i, err := strconv.Atoi(key[1 : len(key)-len(kind)])
+		if err != nil || i < 0 || i >= len(o.Fbest) {
+			break
+		}
+		switch kind {
+		case "best":
+			return append(res, o.Fbest[i]...)
+		case "mean":
+			return append(res, o.Fmean[i]...)
+		}
+		return append(res, o.Fworst[i]...)
+	}
+	chk.Panic("key of history %q is invalid. options are %v", key, o.Keys())
+	return
+}
+
+// WriteCSV writes history to a ".csv" file with one column per key. see Keys
+func (o *History) WriteCSV(dirout, fnkey string) {
+	var buf bytes.Buffer
+	keys := o.Keys()
+	cols := make([][]float64, len(keys))
+	for j, key := range keys {
+		cols[j] = o.Series(key)
+	}
+	io.Ff(&buf, "%s\n", strings.Join(keys, ","))
+	for i := range o.Times {
+		for j := range keys {
+			if j > 0 {
+				io.Ff(&buf, ",")
+			}
+			io.Ff(&buf, "%g", cols[j][i])
+		}
+		io.Ff(&buf, "\n")
+	}
+	io.WriteFileVD(dirout, fnkey+".csv", &buf)
+}
+
+// WriteHistories writes the histories of many runs (e.g. Stat.Histories) to a ".json" file that
+// can be read by ReadHistories
+func WriteHistories(dirout, fnkey string, hists []*History) (err error) {
+	b, err := json.MarshalIndent(hists, "", "  ")
+	if err != nil {
+		return chk.Err("cannot encode histories:\n%v", err)
+	}
+	io.WriteFileVD(dirout, fnkey+".json", bytes.NewBuffer(b))
+	return
+}
+
+// ReadHistories reads histories from a ".json" file written by WriteHistories
+func ReadHistories(filename string) (hists []*History, err error) {
+	b, err := io.ReadFile(filename)
+	if err != nil {
+		return nil, chk.Err("cannot read histories file %q:\n%v", filename, err)
+	}
+	err = json.Unmarshal(b, &hists)
+	if err != nil {
+		return nil, chk.Err("cannot decode histories file %q:\n%v", filename, err)
+	}
+	return
+}
+
+// HistoryQuantiles aggregates the histories of many runs; e.g. of RunMany
+//  key -- key of series. see Keys
+//  qs  -- quantiles; e.g. [0.25, 0.5, 0.75] for the quartiles and median
+//  T   -- [ntimes] recording times; the shortest history of all runs is considered
+//  Q   -- [len(qs)][ntimes] quantiles of values at T
+//  Note: quantiles are linearly interpolated between order statistics
+func HistoryQuantiles(hists []*History, key string, qs []float64) (T []float64, Q [][]float64) {
+	if len(hists) == 0 {
+		return
+	}
+	series := make([][]float64, len(hists))
+	ntimes := len(hists[0].Times)
+	for k, h := range hists {
+		series[k] = h.Series(key)
+		if len(h.Times) < ntimes {
+			ntimes = len(h.Times)
+		}
+	}
+	T = hists[0].Series("time")[:ntimes]
+	Q = make([][]float64, len(qs))
+	for j := range qs {
+		Q[j] = make([]float64, ntimes)
+	}
+	vals := make([]float64, len(hists))
+	for i := 0; i < ntimes; i++ {
+		for k := range hists {
+			vals[k] = series[k][i]
+		}
+		sort.Float64s(vals)
+		for j, q := range qs {
+			Q[j][i] = quantile(vals, q)
+		}
+	}
+	return
+}
+
+// PlotHistories plots convergence curves with the median (and quartiles) of many runs
+//  key       -- key of series. see Keys
+//  useNfeval -- use the median number of function evaluations instead of time as x-axis
+//  args      -- arguments for median; e.g. "'b-', label='DE'"
+//  argsQ     -- arguments for quartiles. "" means "'k--', lw=0.7"
+func PlotHistories(hists []*History, key string, useNfeval bool, args, argsQ string) {
+	if len(hists) == 0 {
+		return
+	}
+	if argsQ == "" {
+		argsQ = "'k--', lw=0.7"
+	}
+	X, Q := HistoryQuantiles(hists, key, []float64{0.25, 0.5, 0.75})
+	xlabel := "time"
+	if useNfeval {
+		_, N := HistoryQuantiles(hists, "nfeval", []float64{0.5})
+		X, xlabel = N[0][:len(X)], "$n_{feval}$"
+	}
+	plt.Plot(X, Q[0], argsQ)
+	plt.Plot(X, Q[2], argsQ)
+	plt.Plot(X, Q[1], args)
+	plt.Gll(xlabel, key, "")
+}
+
+// quantile computes the q-quantile of sorted values by linear interpolation
+func quantile(sorted []float64, q float64) float64 {
+	n := len(sorted)
+	if n == 0 {
+		return math.NaN()
+	}
+	h := q * float64(n-1)
+	i := int(math.Floor(h))
+	if i >= n-1 {
+		return sorted[n-1]
+	}
+	if i < 0 {
+		return sorted[0]
+	}
+	return sorted[i] + (h-float64(i))*(sorted[i+1]-sorted[i])
+}
+
+// hist_update records the convergence history
+//  Note: Metrics must have been computed with the current solutions
+func (o *Optimiser) hist_update(time int) {
+	if o.ConvHist == "" {
+		return
+	}
+	h := o.History
+	if h == nil {
+		h = &History{
+			Fbest:  make([][]float64, o.Nova),
+			Fmean:  make([][]float64, o.Nova),
+			Fworst: make([][]float64, o.Nova),
+			Ind:    make(map[string][]float64),
+		}
+		if o.Nova > 1 {
+			h.IndKeys = o.Multi_indKeys
+		}
+		o.History = h
+	}
+	h.Times = append(h.Times, time)
+	h.Nfeval = append(h.Nfeval, o.Nfeval)
+
+	// feasible solutions and fronts
+	var sols []*Solution
+	nfronts, front0 := 0, 0
+	for _, sol := range o.Solutions {
+		if sol.Feasible() {
+			sols = append(sols, sol)
+		}
+		if sol.FrontId+1 > nfronts {
+			nfronts = sol.FrontId + 1
+		}
+		if sol.FrontId == 0 {
+			front0++
+		}
+	}
+	h.Feasible = append(h.Feasible, float64(len(sols))/float64(len(o.Solutions)))
+	h.Nfronts = append(h.Nfronts, nfronts)
+	h.Front0 = append(h.Front0, front0)
+	if len(sols) == 0 {
+		sols = o.Solutions
+	}
+
+	// objective values
+	for i := 0; i < o.Nova; i++ {
+		fmin, fsum, fmax := sols[0].Ova[i], 0.0, sols[0].Ova[i]
+		for _, sol := range sols {
+			fmin = math.Min(fmin, sol.Ova[i])
+			fmax = math.Max(fmax, sol.Ova[i])
+			fsum += sol.Ova[i]
+		}
+		h.Fbest[i] = append(h.Fbest[i], fmin)
+		h.Fmean[i] = append(h.Fmean[i], fsum/float64(len(sols)))
+		h.Fworst[i] = append(h.Fworst[i], fmax)
+	}
+	h.Diversity = append(h.Diversity, o.diversity(o.Solutions))
+
+	// quality indicators: worst values (INF or 0 for HV) if there are no feasible solutions; as in RunMany
+	if len(h.IndKeys) > 0 {
+		F := FrontOvas(o.Solutions)
+		for _, key := range h.IndKeys {
+			if len(F) == 0 {
+				val := INF
+				if LargerIsBetter(key) {
+					val = 0
+				}
+				h.Ind[key] = append(h.Ind[key], val)
+				continue
+			}
+			val, err := indicators.Calc(key, F, o.Multi_fStar, o.Multi_hvRef)
+			if err != nil {
+				chk.Panic("cannot compute quality indicator:\n%v", err)
+			}
+			h.Ind[key] = append(h.Ind[key], val)
+		}
+	}
+}
+
+// diversity computes the average distance of solutions to their centroid in the space of
+// variables normalised by their ranges
+//  Note: with variable-length chromosomes, the centroid of each gene is computed with the
+//        solutions having that gene
+func (o *Optimiser) diversity(sols []*Solution) (div float64) {
+	if len(sols) == 0 {
+		return
+	}
+	scale := func(k int, isInt bool) float64 {
+		δ := 1.0
+		if isInt && len(o.DelInt) > 0 {
+			δ = float64(o.DelInt[k%len(o.DelInt)])
+		} else if !isInt && len(o.DelFlt) > 0 {
+			δ = o.DelFlt[k%len(o.DelFlt)]
+		}
+		if δ <= 0 {
+			return 1
+		}
+		return δ
+	}
+	var cflt, nflt, cint, nint []float64
+	accum := func(c, n []float64, k int, v float64) ([]float64, []float64) {
+		for len(c) <= k {
+			c, n = append(c, 0), append(n, 0)
+		}
+		c[k] += v
+		n[k]++
+		return c, n
+	}
+	for _, sol := range sols {
+		for k, v := range sol.Flt {
+			cflt, nflt = accum(cflt, nflt, k, v/scale(k, false))
+		}
+		for k, v := range sol.Int {
+			cint, nint = accum(cint, nint, k, float64(v)/scale(k, true))
+		}
+	}
+	for k := range cflt {
+		cflt[k] /= nflt[k]
+	}
+	for k := range cint {
+		cint[k] /= nint[k]
+	}
+	for _, sol := range sols {
+		d := 0.0
+		for k, v := range sol.Flt {
+			d += math.Pow(v/scale(k, false)-cflt[k], 2)
+		}
+		for k, v := range sol.Int {
+			d += math.Pow(float64(v)/scale(k, true)-cint[k], 2)
+		}
+		div += math.Sqrt(d)
+	}
+	return div / float64(len(sols))
+}
@@ -0,0 +1,107 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import (
+	"sort"
+)
+
+// HV computes the hypervolume of the region dominated by F and bounded by the reference point.
+// Larger values mean that F is accurate and well spread
+//  ref -- reference point; e.g. slightly worse than the Nadir point of the Pareto front
+//  Note: points that do not dominate ref are ignored. The volume is computed exactly by sweeping
+//        for 2 and 3 objectives and by the WFG algorithm for more objectives
+//  Reference: While L, Bradstreet L, Barone L (2012) A fast way of calculating exact
+//             hypervolumes. IEEE Transactions on Evolutionary Computation, 16(1):86-95
+func HV(F [][]float64, ref []float64) float64 {
+	var P [][]float64
+	for _, a := range F {
+		inside := true
+		for k := range ref {
+			if a[k] >= ref[k] {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			P = append(P, a)
+		}
+	}
+	return hv(NonDominated(P), ref)
+}
+
+// hv computes the hypervolume of non-dominated points inside the box bounded by ref
+func hv(P [][]float64, ref []float64) (vol float64) {
+	if len(P) == 0 {
+		return
+	}
+	nf := len(ref)
+	switch nf {
+	case 1:
+		return ref[0] - Ideal(P)[0]
+	case 2:
+		return hv2(P, ref)
+	case 3:
+		Q := sortedBy(P, 2)
+		for i := range Q {
+			top := ref[2]
+			if i < len(Q)-1 {
+				top = Q[i+1][2]
+			}
+			if top > Q[i][2] {
+				vol += hv2(Q[:i+1], ref) * (top - Q[i][2])
+			}
+		}
+		return
+	}
+
+	// WFG: sum of exclusive hypervolumes with points sorted by the last objective
+	Q := sortedBy(P, nf-1)
+	for i := len(Q) - 1; i >= 0; i-- {
+		incl := 1.0
+		for k := range ref {
+			incl *= ref[k] - Q[i][k]
+		}
+		vol += incl - hv(limitSet(Q[i+1:], Q[i]), ref)
+	}
+	return
+}
+
+// hv2 computes the hypervolume in 2D; P may have dominated points
+func hv2(P [][]float64, ref []float64) (vol float64) {
+	Q := sortedBy(P, 0)
+	f1 := ref[1]
+	for _, a := range Q {
+		if a[1] < f1 {
+			vol += (ref[0] - a[0]) * (f1 - a[1])
+			f1 = a[1]
+		}
+	}
+	return
+}
+
+// limitSet returns the non-dominated points of {max(p, q) : q ∈ Q}; i.e. the parts of the
+// points in Q that are dominated by p
+func limitSet(Q [][]float64, p []float64) [][]float64 {
+	L := make([][]float64, len(Q))
+	for i, q := range Q {
+		L[i] = make([]float64, len(p))
+		for k := range p {
+			L[i][k] = q[k]
+			if p[k] > q[k] {
+				L[i][k] = p[k]
+			}
+		}
+	}
+	return NonDominated(L)
+}
+
+// sortedBy returns a copy of P sorted by objective k in increasing order
+func sortedBy(P [][]float64, k int) (Q [][]float64) {
+	Q = make([][]float64, len(P))
+	copy(Q, P)
+	sort.SliceStable(Q, func(i, j int) bool { return Q[i][k] < Q[j][k] })
+	return
+}
@@ -0,0 +1,366 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// package indicators implements quality indicators of approximations of Pareto fronts
+//  Note: all objectives are minimised. F is a matrix [npoints][nf] with the objective values of
+//        the approximation and ref is a matrix [nref][nf] with reference points on the Pareto front
+package indicators
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// Names holds the keys of all indicators computed by Calc
+var Names = []string{"IGD", "IGD+", "GD", "EPS+", "EPS*", "SP", "DELTA", "R2", "HV"}
+
+// Calc computes an indicator by key
+//  key   -- one of Names: "IGD", "IGD+", "GD", "EPS+" (additive ε), "EPS*" (multiplicative ε),
+//           "SP" (spacing), "DELTA" (spread), "R2" or "HV" (hypervolume)
+//  ref   -- reference points on the Pareto front. Not used by "SP" and "HV"
+//  hvRef -- reference point of the hypervolume. nil means Nadir(ref) + 0.1 (Nadir(ref) - Ideal(ref))
+//  Note: R2 uses the ideal point of ref and the weights Weights(nf, h) with about 100 vectors
+func Calc(key string, F, ref [][]float64, hvRef []float64) (res float64, err error) {
+	if len(F) == 0 {
+		return 0, chk.Err("there are no points to compute indicator %q", key)
+	}
+	err = Check(key, ref, hvRef)
+	if err != nil {
+		return
+	}
+	switch key {
+	case "IGD":
+		return IGD(F, ref), nil
+	case "IGD+":
+		return IGDplus(F, ref), nil
+	case "GD":
+		return GD(F, ref), nil
+	case "EPS+":
+		return EpsAdd(F, ref), nil
+	case "EPS*":
+		return EpsMul(F, ref), nil
+	case "SP":
+		return Spacing(F), nil
+	case "DELTA":
+		return Spread(F, ref), nil
+	case "R2":
+		nf := len(F[0])
+		if nf < 2 {
+			return 0, chk.Err("indicator %q requires at least 2 objectives. nf = %d is invalid", key, nf)
+		}
+		h := 1
+		for len(Weights(nf, h+1)) <= 100 {
+			h++
+		}
+		return R2(F, Weights(nf, h), Ideal(ref)), nil
+	case "HV":
+		if hvRef == nil {
+			lo, hi := Ideal(ref), Nadir(ref)
+			hvRef = make([]float64, len(hi))
+			for k := range hi {
+				hvRef[k] = hi[k] + 0.1*(hi[k]-lo[k])
+			}
+		}
+		return HV(F, hvRef), nil
+	}
+	return
+}
+
+// Check checks whether the indicator with key can be computed by Calc with ref and hvRef; i.e.
+// whether the key is valid and the required reference data are given
+func Check(key string, ref [][]float64, hvRef []float64) error {
+	known := false
+	for _, name := range Names {
+		if key == name {
+			known = true
+		}
+	}
+	if !known {
+		return chk.Err("indicator %q is not available. options are %v", key, Names)
+	}
+	needsRef := key != "SP" && !(key == "HV" && hvRef != nil)
+	if needsRef && len(ref) == 0 {
+		return chk.Err("reference points are required to compute indicator %q", key)
+	}
+	if key == "R2" && len(ref) > 0 && len(ref[0]) < 2 {
+		return chk.Err("indicator %q requires at least 2 objectives. len(ref[0]) = %d is invalid", key, len(ref[0]))
+	}
+	return nil
+}
+
+// IGD computes the inverted generational distance; i.e. the average distance from the reference
+// points to their closest points in F. Smaller values mean that F is accurate and well spread
+func IGD(F, ref [][]float64) (res float64) {
+	for _, z := range ref {
+		res += minDist(z, F, func(a, z []float64) float64 { return dist(a, z) })
+	}
+	return res / float64(len(ref))
+}
+
+// IGDplus computes the IGD+ indicator, which only considers the components of the distance where
+// points of F are worse than the reference points. IGD+ is weakly Pareto compliant
+//  Reference: Ishibuchi H, Masuda H, Tanigaki Y, Nojima Y (2015) Modified distance calculation in
+//             generational distance and inverted generational distance. EMO 2015, LNCS 9019
+func IGDplus(F, ref [][]float64) (res float64) {
+	for _, z := range ref {
+		res += minDist(z, F, func(a, z []float64) (d float64) {
+			for k := range z {
+				if a[k] > z[k] {
+					d += (a[k] - z[k]) * (a[k] - z[k])
+				}
+			}
+			return math.Sqrt(d)
+		})
+	}
+	return res / float64(len(ref))
+}
+
+// GD computes the generational distance; i.e. the average distance from the points in F to their
+// closest reference points. Smaller values mean that F is close to the Pareto front
+func GD(F, ref [][]float64) (res float64) {
+	for _, a := range F {
+		res += minDist(a, ref, func(z, a []float64) float64 { return dist(a, z) })
+	}
+	return res / float64(len(F))
+}
+
+// EpsAdd computes the additive ε-indicator; i.e. the smallest ε such that every reference point
+// is weakly dominated by a point of F translated by -ε
+func EpsAdd(F, ref [][]float64) (res float64) {
+	res = math.Inf(-1)
+	for _, z := range ref {
+		res = math.Max(res, minDist(z, F, func(a, z []float64) (eps float64) {
+			eps = math.Inf(-1)
+			for k := range z {
+				eps = math.Max(eps, a[k]-z[k])
+			}
+			return
+		}))
+	}
+	return
+}
+
+// EpsMul computes the multiplicative ε-indicator; i.e. the smallest ε such that every reference
+// point is weakly dominated by a point of F divided by ε
+//  Note: all objective values must be positive
+func EpsMul(F, ref [][]float64) (res float64) {
+	res = math.Inf(-1)
+	for _, z := range ref {
+		res = math.Max(res, minDist(z, F, func(a, z []float64) (eps float64) {
+			eps = math.Inf(-1)
+			for k := range z {
+				eps = math.Max(eps, a[k]/z[k])
+			}
+			return
+		}))
+	}
+	return
+}
+
+// Spacing computes Schott's spacing metric; i.e. the standard deviation of the (Manhattan)
+// distances from each point in F to its closest neighbour. Zero means equally spaced points
+func Spacing(F [][]float64) (res float64) {
+	n := len(F)
+	if n < 2 {
+		return
+	}
+	d := neighbourDists(F, func(a, b []float64) (res float64) {
+		for k := range a {
+			res += math.Abs(a[k] - b[k])
+		}
+		return
+	})
+	ave := 0.0
+	for _, v := range d {
+		ave += v / float64(n)
+	}
+	for _, v := range d {
+		res += (v - ave) * (v - ave)
+	}
+	return math.Sqrt(res / float64(n-1))
+}
+
+// Spread computes the spread Δ (diversity) indicator, which considers the distances from the
+// extreme reference points to F and the deviation of the distances between neighbours in F.
+// Zero means that F is equally spaced and reaches the extremes of the Pareto front
+//  Note: the generalisation of Deb's Δ to many objectives by Zhou et al. is used. The extreme
+//        points are the reference points with minimum value of each objective
+//  Reference: Zhou A, Jin Y, Zhang Q, Sendhoff B, Tsang E (2006) Combining model-based and
+//             genetics-based offspring generation for multi-objective optimization using a
+//             convergence criterion. IEEE Congress on Evolutionary Computation
+func Spread(F, ref [][]float64) (res float64) {
+	dext := 0.0
+	for k := range ref[0] {
+		ext := ref[0]
+		for _, z := range ref {
+			if z[k] < ext[k] {
+				ext = z
+			}
+		}
+		dext += minDist(ext, F, func(a, z []float64) float64 { return dist(a, z) })
+	}
+	n := len(F)
+	if n < 2 {
+		return 1
+	}
+	d := neighbourDists(F, dist)
+	ave := 0.0
+	for _, v := range d {
+		ave += v / float64(n)
+	}
+	for _, v := range d {
+		res += math.Abs(v - ave)
+	}
+	den := dext + float64(n)*ave
+	if den == 0 {
+		return 0
+	}
+	return (dext + res) / den
+}
+
+// R2 computes the R2 indicator with the weighted Tchebycheff utility function; i.e. the average
+// over weight vectors of the smallest weighted distance from the ideal point to the points in F
+//  W     -- weight vectors [nw][nf]; e.g. from Weights
+//  ideal -- ideal point; e.g. Ideal(ref)
+//  Reference: Brockhoff D, Wagner T, Trautmann H (2012) On the properties of the R2 indicator.
+//             GECCO 2012
+func R2(F, W [][]float64, ideal []float64) (res float64) {
+	for _, w := range W {
+		res += minDist(w, F, func(a, w []float64) (u float64) {
+			for k := range w {
+				u = math.Max(u, w[k]*math.Abs(ideal[k]-a[k]))
+			}
+			return
+		})
+	}
+	return res / float64(len(W))
+}
+
+// Weights returns the weight vectors of the simplex-lattice design with h divisions; i.e. all
+// vectors with components in {0, 1/h, ..., 1} that sum up to one
+func Weights(nf, h int) (W [][]float64) {
+	w := make([]int, nf)
+	var gen func(k, left int)
+	gen = func(k, left int) {
+		if k == nf-1 {
+			w[k] = left
+			v := make([]float64, nf)
+			for i := range w {
+				v[i] = float64(w[i]) / float64(h)
+			}
+			W = append(W, v)
+			return
+		}
+		for i := 0; i <= left; i++ {
+			w[k] = i
+			gen(k+1, left-i)
+		}
+	}
+	gen(0, h)
+	return
+}
+
+// Ideal returns the ideal point; i.e. the minimum of each objective
+func Ideal(F [][]float64) (res []float64) {
+	res = make([]float64, len(F[0]))
+	for k := range res {
+		res[k] = math.Inf(1)
+		for _, a := range F {
+			res[k] = math.Min(res[k], a[k])
+		}
+	}
+	return
+}
+
+// Nadir returns the maximum of each objective
+func Nadir(F [][]float64) (res []float64) {
+	res = make([]float64, len(F[0]))
+	for k := range res {
+		res[k] = math.Inf(-1)
+		for _, a := range F {
+			res[k] = math.Max(res[k], a[k])
+		}
+	}
+	return
+}
+
+// NonDominated returns the points of F that are not dominated by other points of F
+//  Note: only the first of repeated points is kept
+func NonDominated(F [][]float64) (res [][]float64) {
+	for i, a := range F {
+		dominated := false
+		for j, b := range F {
+			if i == j {
+				continue
+			}
+			if dominates(b, a) || (j < i && equal(a, b)) {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			res = append(res, a)
+		}
+	}
+	return
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// dist computes the Euclidean distance between a and b
+func dist(a, b []float64) float64 {
+	d := 0.0
+	for k := range a {
+		d += (a[k] - b[k]) * (a[k] - b[k])
+	}
+	return math.Sqrt(d)
+}
+
+// minDist returns the minimum of d(a, z) over all points a in F
+func minDist(z []float64, F [][]float64, d func(a, z []float64) float64) (res float64) {
+	res = math.Inf(1)
+	for _, a := range F {
+		res = math.Min(res, d(a, z))
+	}
+	return
+}
+
+// neighbourDists returns the distances between each point in F and its closest neighbour
+func neighbourDists(F [][]float64, d func(a, b []float64) float64) (res []float64) {
+	res = make([]float64, len(F))
+	for i, a := range F {
+		res[i] = math.Inf(1)
+		for j, b := range F {
+			if i != j {
+				res[i] = math.Min(res[i], d(a, b))
+			}
+		}
+	}
+	return
+}
+
+// dominates returns whether a dominates b
+func dominates(a, b []float64) bool {
+	better := false
+	for k := range a {
+		if a[k] > b[k] {
+			return false
+		}
+		if a[k] < b[k] {
+			better = true
+		}
+	}
+	return better
+}
+
+// equal returns whether a and b are equal
+func equal(a, b []float64) bool {
+	for k := range a {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+	return true
+}
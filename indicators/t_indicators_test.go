@@ -0,0 +1,243 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package indicators
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/rnd"
+	"github.com/cpmech/gosl/utl"
+)
+
+func init() {
+	io.Verbose = false
+}
+
+func verbose() {
+	io.Verbose = true
+	chk.Verbose = true
+}
+
+// linearFront returns n equally spaced points on the front f0 + f1 = 1
+func linearFront(n int) (F [][]float64) {
+	for _, v := range utl.LinSpace(0, 1, n) {
+		F = append(F, []float64{v, 1 - v})
+	}
+	return
+}
+
+func Test_ind01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ind01. distance based indicators")
+
+	ref := linearFront(11)
+	chk.Scalar(tst, "IGD(ref,ref)", 1e-15, IGD(ref, ref), 0)
+	chk.Scalar(tst, "IGD+(ref,ref)", 1e-15, IGDplus(ref, ref), 0)
+	chk.Scalar(tst, "GD(ref,ref)", 1e-15, GD(ref, ref), 0)
+	chk.Scalar(tst, "EPS+(ref,ref)", 1e-15, EpsAdd(ref, ref), 0)
+	chk.Scalar(tst, "EPS*(ref,ref)", 1e-15, EpsMul(ref[1:10], ref[1:10]), 1)
+	chk.Scalar(tst, "SP(ref)", 1e-15, Spacing(ref), 0)
+	chk.Scalar(tst, "DELTA(ref,ref)", 1e-15, Spread(ref, ref), 0)
+
+	// translated front
+	F := make([][]float64, len(ref))
+	for i, z := range ref {
+		F[i] = []float64{z[0] + 0.1, z[1] + 0.1}
+	}
+	d := 0.1 * math.Sqrt2
+	chk.Scalar(tst, "IGD", 1e-15, IGD(F, ref), d)
+	chk.Scalar(tst, "IGD+", 1e-15, IGDplus(F, ref), d)
+	chk.Scalar(tst, "GD", 1e-15, GD(F, ref), d)
+	chk.Scalar(tst, "EPS+", 1e-15, EpsAdd(F, ref), 0.1)
+	chk.Scalar(tst, "SP", 1e-15, Spacing(F), 0)
+
+	// IGD+ ignores better components; e.g. of points below the front
+	for i, z := range ref {
+		F[i] = []float64{z[0] - 0.1, z[1]}
+	}
+	chk.Scalar(tst, "IGD+: better", 1e-15, IGDplus(F, ref), 0)
+	chk.Scalar(tst, "EPS+: better", 1e-15, EpsAdd(F, ref), 0)
+	if IGD(F, ref) < 0.05 {
+		tst.Errorf("IGD should be positive\n")
+	}
+
+	// single point at the middle of the front
+	F = [][]float64{{0.5, 0.5}}
+	chk.Scalar(tst, "IGD: one point", 1e-15, IGD(F, [][]float64{{0, 1}, {1, 0}}), math.Sqrt(0.5))
+	chk.Scalar(tst, "EPS+: one point", 1e-15, EpsAdd(F, ref), 0.5)
+	chk.Scalar(tst, "EPS*: one point", 1e-15, EpsMul(F, [][]float64{{0.25, 1}, {1, 0.25}}), 2)
+	chk.Scalar(tst, "DELTA: one point", 1e-15, Spread(F, ref), 1)
+
+	// spacing and spread of unequally spaced points
+	F = [][]float64{{0, 1}, {0.1, 0.9}, {1, 0}}
+	chk.Scalar(tst, "SP", 1e-15, Spacing(F), math.Sqrt(15.36/9/2))
+	if Spread(F, ref) < 0.1 {
+		tst.Errorf("spread of unequally spaced points should be positive\n")
+	}
+}
+
+func Test_ind02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ind02. R2, weights and non-dominated points")
+
+	W := Weights(3, 2)
+	chk.IntAssert(len(W), 6)
+	for _, w := range W {
+		chk.Scalar(tst, "Σw", 1e-15, w[0]+w[1]+w[2], 1)
+	}
+	chk.IntAssert(len(Weights(2, 99)), 100)
+
+	ref := linearFront(11)
+	W = Weights(2, 10)
+	chk.Scalar(tst, "R2(ideal)", 1e-15, R2([][]float64{{0, 0}}, W, Ideal(ref)), 0)
+	chk.Scalar(tst, "R2(nadir)", 1e-15, R2([][]float64{{1, 1}}, W, Ideal(ref)), 85.0/110.0)
+	if R2(ref, W, Ideal(ref)) >= R2(ref[3:7], W, Ideal(ref)) {
+		tst.Errorf("R2 of complete front should be smaller\n")
+	}
+
+	chk.Vector(tst, "ideal", 1e-15, Ideal(ref), []float64{0, 0})
+	chk.Vector(tst, "nadir", 1e-15, Nadir(ref), []float64{1, 1})
+
+	F := [][]float64{{1, 3}, {2, 2}, {2, 2}, {3, 3}, {3, 1}, {1, 4}}
+	N := NonDominated(F)
+	chk.IntAssert(len(N), 3)
+	chk.Matrix(tst, "nondominated", 1e-15, N, [][]float64{{1, 3}, {2, 2}, {3, 1}})
+}
+
+func Test_ind03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ind03. hypervolume")
+
+	// 2D
+	F := [][]float64{{1, 3}, {2, 2}, {3, 3}, {3, 1}, {5, 0}}
+	chk.Scalar(tst, "HV2", 1e-15, HV(F, []float64{4, 4}), 6)
+	chk.Scalar(tst, "HV2: linear", 1e-4, HV(linearFront(5001), []float64{1, 1}), 0.5)
+
+	// 3D: one point and two points
+	chk.Scalar(tst, "HV3: box", 1e-15, HV([][]float64{{0, 0, 0}}, []float64{1, 2, 3}), 6)
+	F = [][]float64{{0, 0, 1}, {1, 1, 0}}
+	chk.Scalar(tst, "HV3", 1e-15, HV(F, []float64{2, 2, 2}), 4+1)
+
+	// 3D: spherical front f0² + f1² + f2² = 1 with ref = (1,1,1) => HV = 1 - π/6
+	var S [][]float64
+	n := 60
+	for i := 0; i <= n; i++ {
+		for j := 0; j <= n-i; j++ {
+			a := []float64{float64(i) / float64(n), float64(j) / float64(n), float64(n-i-j) / float64(n)}
+			r := math.Sqrt(a[0]*a[0] + a[1]*a[1] + a[2]*a[2])
+			S = append(S, []float64{a[0] / r, a[1] / r, a[2] / r})
+		}
+	}
+	hv3 := HV(S, []float64{1, 1, 1})
+	io.Pforan("hv3 = %v (%v)\n", hv3, 1-math.Pi/6)
+	chk.Scalar(tst, "HV3: sphere", 0.02, hv3, 1-math.Pi/6)
+
+	// WFG (4D) against sweeping (3D) with constant last objective
+	rnd.Init(1234)
+	var F3, F4 [][]float64
+	for i := 0; i < 40; i++ {
+		a := []float64{rnd.Float64(0, 1), rnd.Float64(0, 1), 0}
+		a[2] = 1.5 - a[0] - a[1] + rnd.Float64(0, 0.2)
+		F3 = append(F3, a)
+		F4 = append(F4, []float64{a[0], a[1], a[2], 0.5})
+	}
+	ref3, ref4 := []float64{1.1, 1.1, 1.8}, []float64{1.1, 1.1, 1.8, 2.5}
+	chk.Scalar(tst, "HV4 = HV3×2", 1e-13, HV(F4, ref4), 2*HV(F3, ref3))
+
+	// WFG (4D) against Monte Carlo
+	var G [][]float64
+	for i := 0; i < 30; i++ {
+		a := []float64{rnd.Float64(0, 1), rnd.Float64(0, 1), rnd.Float64(0, 1), 0}
+		a[3] = math.Max(0, 2-a[0]-a[1]-a[2])
+		G = append(G, a)
+	}
+	ref4 = []float64{1, 1, 1, 2}
+	nmc, ndom := 200000, 0
+	for k := 0; k < nmc; k++ {
+		p := []float64{rnd.Float64(0, 1), rnd.Float64(0, 1), rnd.Float64(0, 1), rnd.Float64(0, 2)}
+		for _, a := range G {
+			if a[0] <= p[0] && a[1] <= p[1] && a[2] <= p[2] && a[3] <= p[3] {
+				ndom++
+				break
+			}
+		}
+	}
+	hv4, hvmc := HV(G, ref4), 2*float64(ndom)/float64(nmc)
+	io.Pforan("hv4 = %v (Monte Carlo = %v)\n", hv4, hvmc)
+	chk.Scalar(tst, "HV4: Monte Carlo", 0.01, hv4, hvmc)
+
+	// points outside box
+	chk.Scalar(tst, "HV: outside", 1e-15, HV([][]float64{{2, 0}, {0, 1}}, []float64{1, 1}), 0)
+}
+
+func Test_ind04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ind04. Calc")
+
+	ref := linearFront(11)
+	for _, key := range Names {
+		F := ref
+		if key == "EPS*" { // without zero values
+			F = ref[1:10]
+		}
+		val, err := Calc(key, F, F, nil)
+		if err != nil {
+			tst.Errorf("Calc failed:\n%v\n", err)
+			return
+		}
+		io.Pforan("%-5s = %v\n", key, val)
+		switch key {
+		case "EPS*":
+			chk.Scalar(tst, key, 1e-15, val, 1)
+		case "R2":
+			if val <= 0 || val > 0.25 { // utility of the front itself
+				tst.Errorf("R2 of reference points is incorrect: %g\n", val)
+			}
+		case "HV":
+			chk.Scalar(tst, key, 1e-15, val, 1.1*1.1-0.5-0.1*0.5)
+		default:
+			chk.Scalar(tst, key, 1e-15, val, 0)
+		}
+	}
+	val, _ := Calc("HV", ref, nil, []float64{2, 2})
+	chk.Scalar(tst, "HV", 1e-15, val, 4-0.5-0.1*0.5)
+
+	// errors
+	for _, test := range []struct {
+		key    string
+		F, ref [][]float64
+	}{
+		{"XYZ", ref, ref}, {"IGD", nil, ref}, {"IGD", ref, nil}, {"HV", ref, nil},
+		{"R2", [][]float64{{1}, {2}}, [][]float64{{0}, {1}}},
+	} {
+		_, err := Calc(test.key, test.F, test.ref, nil)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("Calc should have failed with %q\n", test.key)
+		}
+	}
+
+	// checks without points
+	for _, key := range []string{"XYZ", "IGD", "HV"} {
+		if Check(key, nil, nil) == nil {
+			tst.Errorf("Check should have failed with %q\n", key)
+		}
+	}
+	for _, key := range []string{"SP", "IGD", "HV"} {
+		if err := Check(key, ref, nil); err != nil {
+			tst.Errorf("Check failed with %q:\n%v\n", key, err)
+		}
+	}
+	if err := Check("HV", nil, []float64{2, 2}); err != nil {
+		tst.Errorf("Check failed with hvRef:\n%v\n", err)
+	}
+}
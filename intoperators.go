@@ -49,6 +49,71 @@ func CxInt(a, b, A, B []int, prms *Parameters) {
 	return
 }
 
+// CxIntUnif performs the uniform crossover of genetic data from A and B
+//  Output:
+//   a and b -- offspring
+//  Note: each gene is exchanged with probability 0.5
+//  Example:
+//         0 1 2 3 4 5 6 7
+//     A = a b c d e f g h
+//     B = * . . . . * * *
+//         ↑   ↑ ↑     ↑      exchanged genes
+//     a = * b . . e f * h
+//     b = a . c d . * g *
+func CxIntUnif(a, b, A, B []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPc) || size < 2 {
+		for i := 0; i < len(A); i++ {
+			a[i], b[i] = A[i], B[i]
+		}
+		return
+	}
+	for i := 0; i < size; i++ {
+		if rnd.FlipCoin(0.5) {
+			a[i], b[i] = B[i], A[i]
+		} else {
+			a[i], b[i] = A[i], B[i]
+		}
+	}
+}
+
+// CxIntHux performs the half-uniform crossover (HUX) of genetic data from A and B
+//  Output:
+//   a and b -- offspring
+//  Note: exactly half (rounded down) of the genes that differ in A and B are exchanged
+//  Reference:
+//   [1] Eshelman LJ. The CHC Adaptive Search Algorithm: How to Have Safe Search When Engaging in
+//       Nontraditional Genetic Recombination. Foundations of Genetic Algorithms, 1:265-283; 1991.
+//  Example:
+//         0 1 2 3 4 5 6 7
+//     A = 1 1 0 0 1 0 1 0
+//     B = 1 0 1 0 0 0 1 1    differ at [1, 2, 4, 7] => exchange two of them; e.g. [2, 7]
+//     a = 1 1 1 0 1 0 1 1
+//     b = 1 0 0 0 0 0 1 0
+func CxIntHux(a, b, A, B []int, prms *Parameters) {
+	size := len(A)
+	for i := 0; i < size; i++ {
+		a[i], b[i] = A[i], B[i]
+	}
+	if !rnd.FlipCoin(prms.IntPc) || size < 2 {
+		return
+	}
+	var diff []int
+	for i := 0; i < size; i++ {
+		if A[i] != B[i] {
+			diff = append(diff, i)
+		}
+	}
+	nswap := len(diff) / 2
+	if nswap < 1 {
+		return
+	}
+	for _, k := range rnd.IntGetUniqueN(0, len(diff), nswap) {
+		i := diff[k]
+		a[i], b[i] = B[i], A[i]
+	}
+}
+
 // CxIntOrd performs the crossover in a pair of individuals with integer numbers
 // that correspond to a ordered sequence, e.g. for traveling salesman problem
 //  Output:
@@ -124,10 +189,268 @@ func CxIntOrd(a, b, A, B []int, prms *Parameters) {
 	return
 }
 
+// CxIntPmx performs the partially-mapped crossover (PMX) of ordered sequences of integers
+//  Output:
+//    a and b -- offspring chromosomes
+//  Note: using PMX method explained in [1] (proposed in [2])
+//  References:
+//   [1] Larrañaga P, Kuijpers CMH, Murga RH, Inza I and Dizdarevic S. Genetic Algorithms for the
+//       Travelling Salesman Problem: A Review of Representations and Operators. Artificial
+//       Intelligence Review, 13:129-170; 1999. doi:10.1023/A:1006529012972
+//   [2] Goldberg DE and Lingle R. Alleles, Loci and the Traveling Salesman Problem. Proceedings of
+//       the First International Conference on Genetic Algorithms and Their Applications, 154-159; 1985.
+//  Example:
+//   data:
+//         0 1 2   3 4 5 6   7
+//     A = 0 1 2 | 3 4 5 6 | 7        size = 8
+//     B = 2 6 4 | 0 5 7 1 | 3        cuts = [3, 7]
+//   first step: swap cores => mapping 0↔3, 5↔4, 7↔5, 1↔6
+//     a = . . . | 0 5 7 1 | .
+//     b = . . . | 3 4 5 6 | .
+//   second step: copy remaining from own parent, following the mapping if repeated
+//     a = 3 6 2 | 0 5 7 1 | 4        e.g. 7 is repeated in a => 7 → 5 → 4
+//     b = 2 1 7 | 3 4 5 6 | 0        e.g. 4 is repeated in b => 4 → 5 → 7
+func CxIntPmx(a, b, A, B []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPc) || size < 3 {
+		for i := 0; i < len(A); i++ {
+			a[i], b[i] = A[i], B[i]
+		}
+		return
+	}
+	s := rnd.Int(1, size-2)
+	t := rnd.Int(s+1, size-1)
+	cxIntPmx(a, b, A, B, s, t)
+}
+
+// cxIntPmx implements CxIntPmx with given cuts
+func cxIntPmx(a, b, A, B []int, s, t int) {
+	size := len(A)
+	amap := make([]int, size) // a's core value => b's core value at same position
+	bmap := make([]int, size)
+	acorehas := make([]bool, size)
+	bcorehas := make([]bool, size)
+	for i := s; i < t; i++ {
+		a[i], b[i] = B[i], A[i]
+		amap[B[i]], bmap[A[i]] = A[i], B[i]
+		acorehas[B[i]], bcorehas[A[i]] = true, true
+	}
+	for i := 0; i < size; i++ {
+		if i >= s && i < t {
+			continue
+		}
+		va, vb := A[i], B[i]
+		for acorehas[va] {
+			va = amap[va]
+		}
+		for bcorehas[vb] {
+			vb = bmap[vb]
+		}
+		a[i], b[i] = va, vb
+	}
+}
+
+// CxIntCyc performs the cycle crossover (CX) of ordered sequences of integers
+//  Output:
+//    a and b -- offspring chromosomes
+//  Note: using CX method explained in [1] (proposed in [2])
+//  References:
+//   [1] Larrañaga P, Kuijpers CMH, Murga RH, Inza I and Dizdarevic S. Genetic Algorithms for the
+//       Travelling Salesman Problem: A Review of Representations and Operators. Artificial
+//       Intelligence Review, 13:129-170; 1999. doi:10.1023/A:1006529012972
+//   [2] Oliver IM, Smith DJ and Holland JRC. A Study of Permutation Crossover Operators on the
+//       Travelling Salesman Problem. Proceedings of the Second International Conference on Genetic
+//       Algorithms and Their Applications, 224-230; 1987.
+//  Example:
+//   data:
+//         0 1 2 3 4 5 6 7
+//     A = 0 1 2 3 4 5 6 7
+//     B = 1 3 5 7 6 4 2 0
+//   cycle starting at position 0:
+//     A[0]=0 → B[0]=1 = A[1] → B[1]=3 = A[3] → B[3]=7 = A[7] → B[7]=0 (closed)
+//     positions = {0, 1, 3, 7}
+//   copy cycle positions from own parent and the other positions from the other parent:
+//     a = 0 1 5 3 6 4 2 7
+//     b = 1 3 2 7 4 5 6 0
+func CxIntCyc(a, b, A, B []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPc) || size < 2 {
+		for i := 0; i < len(A); i++ {
+			a[i], b[i] = A[i], B[i]
+		}
+		return
+	}
+	posA := make([]int, size)
+	for i, v := range A {
+		posA[v] = i
+	}
+	incycle := make([]bool, size)
+	for i := 0; !incycle[i]; i = posA[B[i]] {
+		incycle[i] = true
+	}
+	for i := 0; i < size; i++ {
+		if incycle[i] {
+			a[i], b[i] = A[i], B[i]
+		} else {
+			a[i], b[i] = B[i], A[i]
+		}
+	}
+}
+
+// CxIntErx performs the edge recombination crossover (ER) of ordered sequences of integers
+//  Output:
+//    a and b -- offspring chromosomes
+//  Note: using ER method explained in [1] (proposed in [2]). Ties are broken by taking the first
+//        candidate in the edge list and dead ends are resolved by taking a random unvisited item
+//  References:
+//   [1] Larrañaga P, Kuijpers CMH, Murga RH, Inza I and Dizdarevic S. Genetic Algorithms for the
+//       Travelling Salesman Problem: A Review of Representations and Operators. Artificial
+//       Intelligence Review, 13:129-170; 1999. doi:10.1023/A:1006529012972
+//   [2] Whitley D, Starkweather T and Fuquay D. Scheduling Problems and Traveling Salesman: The
+//       Genetic Edge Recombination Operator. Proceedings of the Third International Conference on
+//       Genetic Algorithms, 133-140; 1989.
+//  Example:
+//   data:
+//     A = 0 1 2 3 4 5
+//     B = 1 3 0 5 4 2
+//   edge list (cyclic neighbours in A then B):
+//     0: 1 5 3      3: 4 2 0 1
+//     1: 2 0 3      4: 5 3 2
+//     2: 3 1 4      5: 0 4
+//   a starts with A[0] and then goes to the neighbour with the fewest unused neighbours:
+//     a = 0 5 4 3 2 1
+//   b starts with B[0]:
+//     b = 1 2 3 4 5 0
+func CxIntErx(a, b, A, B []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPc) || size < 3 {
+		for i := 0; i < len(A); i++ {
+			a[i], b[i] = A[i], B[i]
+		}
+		return
+	}
+	edges := make([][]int, size)
+	for _, P := range [][]int{A, B} {
+		for i := 0; i < size; i++ {
+			v := P[i]
+			edges[v] = appendUnique(edges[v], P[(i+1)%size])
+			edges[v] = appendUnique(edges[v], P[(i+size-1)%size])
+		}
+	}
+	erxFill(a, A[0], edges)
+	erxFill(b, B[0], edges)
+}
+
+// erxFill fills child according to the edge list, starting with item first
+func erxFill(child []int, first int, edges [][]int) {
+	size := len(child)
+	used := make([]bool, size)
+	nfree := make([]int, size) // number of unused neighbours
+	for v, e := range edges {
+		nfree[v] = len(e)
+	}
+	cur := first
+	for k := 0; k < size; k++ {
+		child[k] = cur
+		used[cur] = true
+		for _, w := range edges[cur] {
+			nfree[w]--
+		}
+		if k == size-1 {
+			break
+		}
+		next := -1
+		for _, w := range edges[cur] {
+			if used[w] {
+				continue
+			}
+			if next < 0 || nfree[w] < nfree[next] {
+				next = w
+			}
+		}
+		if next < 0 { // dead end
+			var remain []int
+			for v := 0; v < size; v++ {
+				if !used[v] {
+					remain = append(remain, v)
+				}
+			}
+			next = remain[rnd.Int(0, len(remain)-1)]
+		}
+		cur = next
+	}
+}
+
+// CxIntObx performs the order-based crossover (OX2) of ordered sequences of integers
+//  Output:
+//    a and b -- offspring chromosomes
+//  Note: using OX2 method explained in [1] (proposed in [2]). The number of selected positions
+//        is equal to prms.IntNcuts (at least 2)
+//  References:
+//   [1] Larrañaga P, Kuijpers CMH, Murga RH, Inza I and Dizdarevic S. Genetic Algorithms for the
+//       Travelling Salesman Problem: A Review of Representations and Operators. Artificial
+//       Intelligence Review, 13:129-170; 1999. doi:10.1023/A:1006529012972
+//   [2] Syswerda G. Schedule Optimization Using Genetic Algorithms. In Davis L, editor. Handbook of
+//       Genetic Algorithms, 332-349; 1991.
+//  Example:
+//   data:
+//         0 1 2 3 4 5 6 7
+//     A = 0 1 2 3 4 5 6 7
+//     B = 1 3 5 7 6 4 2 0    positions = [1, 2, 5]
+//   the order of B's items at the selected positions (3 5 4) is imposed on those items in A:
+//     a = 0 1 2 3 5 4 6 7
+//   the order of A's items at the selected positions (1 2 5) is imposed on those items in B:
+//     b = 1 3 2 7 6 4 5 0
+func CxIntObx(a, b, A, B []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPc) || size < 3 {
+		for i := 0; i < len(A); i++ {
+			a[i], b[i] = A[i], B[i]
+		}
+		return
+	}
+	npos := prms.IntNcuts
+	if npos < 2 {
+		npos = 2
+	}
+	if npos > size-1 {
+		npos = size - 1
+	}
+	pos := rnd.IntGetUniqueN(0, size, npos)
+	sort.Ints(pos)
+	cxIntObx(a, b, A, B, pos)
+}
+
+// cxIntObx implements CxIntObx with given (sorted) positions
+func cxIntObx(a, b, A, B []int, pos []int) {
+	obxImpose(a, A, B, pos)
+	obxImpose(b, B, A, pos)
+}
+
+// obxImpose imposes the order of Q's items at positions pos on the same items in P => child
+func obxImpose(child, P, Q []int, pos []int) {
+	size := len(P)
+	sel := make([]bool, size)
+	for _, p := range pos {
+		sel[Q[p]] = true
+	}
+	k := 0
+	for i := 0; i < size; i++ {
+		if sel[P[i]] {
+			child[i] = Q[pos[k]]
+			k++
+		} else {
+			child[i] = P[i]
+		}
+	}
+}
+
 // mutation ////////////////////////////////////////////////////////////////////////////////////////
 
 // MtInt performs the mutation of genetic data from A
 //  Output: modified individual 'A'
+//  Note: genes are changed by ±m⋅A[i]; thus zero genes never change and values may fall outside
+//        [IntMin, IntMax]. See MtIntRnd and MtIntCreep for bounded alternatives
 func MtInt(A []int, prms *Parameters) {
 	size := len(A)
 	if !rnd.FlipCoin(prms.IntPm) || size < 1 {
@@ -145,6 +468,44 @@ func MtInt(A []int, prms *Parameters) {
 	}
 }
 
+// MtIntRnd performs the random resetting mutation of genetic data from A
+//  Output: modified individual 'A'
+//  Note: IntNchanges genes are replaced by random values within [IntMin, IntMax]
+func MtIntRnd(A []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPm) || size < 1 {
+		return
+	}
+	pos := rnd.IntGetUniqueN(0, size, prms.IntNchanges)
+	for _, i := range pos {
+		A[i] = rnd.Int(prms.IntMin[i], prms.IntMax[i])
+	}
+}
+
+// MtIntCreep performs the creep mutation of genetic data from A
+//  Output: modified individual 'A'
+//  Note: IntNchanges genes are increased or decreased by a random step in [1, IntCreep] and the
+//        results are kept within [IntMin, IntMax]. Categorical genes are randomly reset instead
+func MtIntCreep(A []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPm) || size < 1 {
+		return
+	}
+	pos := rnd.IntGetUniqueN(0, size, prms.IntNchanges)
+	for _, i := range pos {
+		if prms.IsCat(i) {
+			A[i] = rnd.Int(prms.IntMin[i], prms.IntMax[i])
+			continue
+		}
+		step := rnd.Int(1, prms.IntCreep)
+		if rnd.FlipCoin(0.5) {
+			A[i] = prms.EnforceIntRange(i, A[i]+step)
+		} else {
+			A[i] = prms.EnforceIntRange(i, A[i]-step)
+		}
+	}
+}
+
 // MtIntBin performs the mutation of a binary chromosome
 //  Output: modified individual 'A'
 func MtIntBin(A []int, prms *Parameters) {
@@ -162,6 +523,21 @@ func MtIntBin(A []int, prms *Parameters) {
 	}
 }
 
+// MtIntBinBit performs the bitwise mutation of a binary chromosome
+//  Output: modified individual 'A'
+//  Note: each bit is flipped with probability IntPbit (default = 1/Nint); IntPm is not used
+func MtIntBinBit(A []int, prms *Parameters) {
+	for i := 0; i < len(A); i++ {
+		if rnd.FlipCoin(prms.IntPbit) {
+			if A[i] == 0 {
+				A[i] = 1
+			} else {
+				A[i] = 0
+			}
+		}
+	}
+}
+
 // MtIntOrd performs the mutation of genetic data from a ordered list of integers A
 //  Output: modified individual 'A'
 //  Note: using DM method as explained in [1] (citing [2])
@@ -236,8 +612,189 @@ func MtIntOrd(A []int, prms *Parameters) {
 	}
 }
 
+// MtIntSwap performs the exchange (swap) mutation of ordered sequences of integers
+//  Output: modified individual 'A'
+//  Note: using EM method explained in [1] (citing [2])
+//  References:
+//   [1] Larrañaga P, Kuijpers CMH, Murga RH, Inza I and Dizdarevic S. Genetic Algorithms for the
+//       Travelling Salesman Problem: A Review of Representations and Operators. Artificial
+//       Intelligence Review, 13:129-170; 1999. doi:10.1023/A:1006529012972
+//   [2] Banzhaf W. The "Molecular" Traveling Salesman. Biological Cybernetics, 64:7-14; 1990.
+//  Example:
+//         0 1 2 3 4 5 6 7
+//     A = a b c d e f g h   i = 2, j = 5
+//     A = a b f d e c g h
+func MtIntSwap(A []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPm) || size < 2 {
+		return
+	}
+	pos := rnd.IntGetUniqueN(0, size, 2)
+	A[pos[0]], A[pos[1]] = A[pos[1]], A[pos[0]]
+}
+
+// MtIntInv performs the simple inversion mutation of ordered sequences of integers
+//  Output: modified individual 'A'
+//  Note: using SIM method explained in [1] (citing [2])
+//  References:
+//   [1] Larrañaga P, Kuijpers CMH, Murga RH, Inza I and Dizdarevic S. Genetic Algorithms for the
+//       Travelling Salesman Problem: A Review of Representations and Operators. Artificial
+//       Intelligence Review, 13:129-170; 1999. doi:10.1023/A:1006529012972
+//   [2] Holland JH. Adaptation in Natural and Artificial Systems. University of Michigan Press; 1975.
+//  Example:
+//         0 1 2 3 4 5 6 7
+//     A = a b c d e f g h   s = 2, t = 6
+//     A = a b f e d c g h
+func MtIntInv(A []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPm) || size < 2 {
+		return
+	}
+	s := rnd.Int(0, size-2)
+	t := rnd.Int(s+2, size)
+	mtIntInv(A, s, t)
+}
+
+// mtIntInv reverses A[s:t]
+func mtIntInv(A []int, s, t int) {
+	for i, j := s, t-1; i < j; i, j = i+1, j-1 {
+		A[i], A[j] = A[j], A[i]
+	}
+}
+
+// MtIntIns performs the insertion mutation of ordered sequences of integers
+//  Output: modified individual 'A'
+//  Note: using ISM method explained in [1] (citing [2])
+//  References:
+//   [1] Larrañaga P, Kuijpers CMH, Murga RH, Inza I and Dizdarevic S. Genetic Algorithms for the
+//       Travelling Salesman Problem: A Review of Representations and Operators. Artificial
+//       Intelligence Review, 13:129-170; 1999. doi:10.1023/A:1006529012972
+//   [2] Fogel DB. Applying Evolutionary Programming to Selected Traveling Salesman Problems.
+//       Cybernetics and Systems, 24:27-36; 1993.
+//  Example:
+//         0 1 2 3 4 5 6 7
+//     A = a b c d e f g h   item at i = 1 is moved to j = 4
+//     A = a c d e b f g h
+func MtIntIns(A []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPm) || size < 2 {
+		return
+	}
+	pos := rnd.IntGetUniqueN(0, size, 2)
+	mtIntIns(A, pos[0], pos[1])
+}
+
+// mtIntIns moves item at i to position j
+func mtIntIns(A []int, i, j int) {
+	v := A[i]
+	if i < j {
+		copy(A[i:j], A[i+1:j+1])
+	} else {
+		copy(A[j+1:i+1], A[j:i])
+	}
+	A[j] = v
+}
+
+// MtIntScr performs the scramble mutation of ordered sequences of integers
+//  Output: modified individual 'A'
+//  Note: using SM method explained in [1] (citing [2])
+//  References:
+//   [1] Larrañaga P, Kuijpers CMH, Murga RH, Inza I and Dizdarevic S. Genetic Algorithms for the
+//       Travelling Salesman Problem: A Review of Representations and Operators. Artificial
+//       Intelligence Review, 13:129-170; 1999. doi:10.1023/A:1006529012972
+//   [2] Syswerda G. Schedule Optimization Using Genetic Algorithms. In Davis L, editor. Handbook of
+//       Genetic Algorithms, 332-349; 1991.
+//  Example:
+//         0 1 2 3 4 5 6 7
+//     A = a b c d e f g h   s = 2, t = 6
+//     A = a b e c f d g h   (A[2:6] randomly shuffled)
+func MtIntScr(A []int, prms *Parameters) {
+	size := len(A)
+	if !rnd.FlipCoin(prms.IntPm) || size < 2 {
+		return
+	}
+	s := rnd.Int(0, size-2)
+	t := rnd.Int(s+2, size)
+	GenPermShuffle(A[s:t])
+}
+
 // auxiliary ///////////////////////////////////////////////////////////////////////////////////////
 
+// GrayDecode converts a Gray-coded bit string (most significant bit first) into an integer
+//  Example:
+//    gray = 1 1 0  =>  binary = 1 0 0  =>  k = 4
+func GrayDecode(gray []int) (k uint64) {
+	var bit uint64
+	for _, g := range gray {
+		bit ^= uint64(g & 1)
+		k = k<<1 | bit
+	}
+	return
+}
+
+// GrayEncode converts an integer into a Gray-coded bit string (most significant bit first)
+//  Example:
+//    k = 4  =>  binary = 1 0 0  =>  gray = 1 1 0
+func GrayEncode(gray []int, k uint64) {
+	g := k ^ (k >> 1)
+	n := len(gray)
+	for i := 0; i < n; i++ {
+		gray[i] = int((g >> uint(n-1-i)) & 1)
+	}
+}
+
+// appendUnique appends v to list if not present yet
+func appendUnique(list []int, v int) []int {
+	for _, w := range list {
+		if w == v {
+			return list
+		}
+	}
+	return append(list, v)
+}
+
+// IsPerm checks whether a is a permutation of {0,1,...,len(a)-1}
+func IsPerm(a []int) bool {
+	has := make([]bool, len(a))
+	for _, v := range a {
+		if v < 0 || v >= len(a) || has[v] {
+			return false
+		}
+		has[v] = true
+	}
+	return true
+}
+
+// RepairPerm turns a into a permutation of {0,1,...,len(a)-1} if it is not one already; e.g. after
+// user-defined crossover or mutation. The first occurrences of valid values are kept and the
+// other positions receive the missing values in increasing order
+//  Output: repaired -- a was not a permutation
+func RepairPerm(a []int) (repaired bool) {
+	has := make([]bool, len(a))
+	keep := make([]bool, len(a))
+	for i, v := range a {
+		if v >= 0 && v < len(a) && !has[v] {
+			has[v], keep[i] = true, true
+			continue
+		}
+		repaired = true
+	}
+	if !repaired {
+		return
+	}
+	next := 0
+	for i := range a {
+		if keep[i] {
+			continue
+		}
+		for has[next] {
+			next++
+		}
+		a[i], has[next] = next, true
+	}
+	return
+}
+
 // GenerateCxEnds randomly computes the end positions of cuts in chromosomes
 //  Input:
 //   size  -- size of chromosome
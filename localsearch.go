@@ -0,0 +1,329 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// LocalSearch refines the floats of elite solutions with a derivative-free method (memetic step)
+//  Note: elite solutions are the LsNsol best ones (single-objective or lexicographic problems) or
+//        up to LsNsol solutions from front 0 (multi-objective problems). A refined solution
+//        replaces the original one only if it is better according to lsBetter. Ints are not changed
+//  Output: number of function evaluations
+func (o *Optimiser) LocalSearch() (nfeval int) {
+
+	// select elite solutions
+	elite := o.lsElite
+	copy(elite, o.Solutions)
+	nelite := utl.Imin(o.LsNsol, len(elite))
+	switch {
+	case o.Lexico:
+		SortSolutions(elite, o.LexKeys()...)
+	case o.Nova < 2:
+		SortSolutions(elite, SortKey{Type: KeyOor}, SortKey{Type: KeyOva})
+	default:
+		SortSolutions(elite, SortKey{Type: KeyFront}, SortKey{Type: KeyCrowd})
+		nfront0 := 0
+		for nfront0 < len(elite) && elite[nfront0].FrontId == 0 {
+			nfront0++
+		}
+		nelite = utl.Imin(nelite, nfront0)
+	}
+
+	// refine
+	for _, sol := range elite[:nelite] {
+		if sol.Fixed {
+			continue
+		}
+		best := o.lsPool[0]
+		sol.CopyInto(best)
+		switch o.LsType {
+		case "nm":
+			nfeval += o.lsNelderMead(best)
+		case "hj":
+			nfeval += o.lsHookeJeeves(best)
+		case "cs":
+			nfeval += o.lsCoordSearch(best)
+		default:
+			chk.Panic("local search method %q is not available", o.LsType)
+		}
+		if o.lsBetter(best, sol) {
+			best.CopyInto(sol)
+		}
+	}
+	return
+}
+
+// lsNelderMead runs the Nelder-Mead simplex method starting at A
+//  Output: A becomes the best vertex and number of function evaluations
+//  Reference:
+//   [1] Nelder JA and Mead R. A simplex method for function minimization. The Computer Journal,
+//       7(4):308-313; 1965. doi:10.1093/comjnl/7.4.308
+func (o *Optimiser) lsNelderMead(A *Solution) (nfeval int) {
+
+	// initial simplex: A and n points along the axes
+	n := o.Nflt
+	S := o.lsPool[1 : n+2]
+	xc, xr, xe := o.lsPool[n+2].Flt, o.lsPool[n+3].Flt, o.lsPool[n+4].Flt
+	R, E := o.lsPool[n+3], o.lsPool[n+4]
+	A.CopyInto(S[0])
+	for k := 0; k < n; k++ {
+		copy(xr, A.Flt)
+		δ := o.LsStep * o.DelFlt[k]
+		if xr[k]+δ > o.FltMax[k] {
+			δ = -δ
+		}
+		xr[k] += δ
+		o.lsEval(S[k+1], A, xr)
+		nfeval++
+	}
+
+	// iterations
+	for nfeval < o.LsNfeval {
+
+		// sort vertices and check convergence
+		o.lsSort(S)
+		if o.lsSize(S) < o.LsTol {
+			break
+		}
+
+		// centroid of all vertices but the worst one
+		W := S[n]
+		for i := 0; i < n; i++ {
+			xc[i] = 0
+			for k := 0; k < n; k++ {
+				xc[i] += S[k].Flt[i] / float64(n)
+			}
+		}
+
+		// reflection
+		for i := 0; i < n; i++ {
+			xr[i] = xc[i] + (xc[i] - W.Flt[i])
+		}
+		o.lsEval(R, A, xr)
+		nfeval++
+		if o.lsBetter(R, S[0]) {
+
+			// expansion
+			for i := 0; i < n; i++ {
+				xe[i] = xc[i] + 2.0*(xc[i]-W.Flt[i])
+			}
+			o.lsEval(E, A, xe)
+			nfeval++
+			if o.lsBetter(E, R) {
+				E.CopyInto(W)
+			} else {
+				R.CopyInto(W)
+			}
+			continue
+		}
+		if o.lsBetter(R, S[n-1]) {
+			R.CopyInto(W)
+			continue
+		}
+
+		// contraction (outside if R is better than W; inside otherwise)
+		if o.lsBetter(R, W) {
+			for i := 0; i < n; i++ {
+				xe[i] = xc[i] + 0.5*(R.Flt[i]-xc[i])
+			}
+			o.lsEval(E, A, xe)
+			nfeval++
+			if !o.lsBetter(R, E) {
+				E.CopyInto(W)
+				continue
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				xe[i] = xc[i] + 0.5*(W.Flt[i]-xc[i])
+			}
+			o.lsEval(E, A, xe)
+			nfeval++
+			if o.lsBetter(E, W) {
+				E.CopyInto(W)
+				continue
+			}
+		}
+
+		// shrink towards the best vertex
+		for k := 1; k <= n; k++ {
+			for i := 0; i < n; i++ {
+				xr[i] = S[0].Flt[i] + 0.5*(S[k].Flt[i]-S[0].Flt[i])
+			}
+			o.lsEval(S[k], A, xr)
+			nfeval++
+		}
+	}
+
+	// results
+	o.lsSort(S)
+	if o.lsBetter(S[0], A) {
+		S[0].CopyInto(A)
+	}
+	return
+}
+
+// lsHookeJeeves runs the Hooke-Jeeves pattern search starting at A
+//  Output: A becomes the best point and number of function evaluations
+//  Reference:
+//   [1] Hooke R and Jeeves TA. "Direct search" solution of numerical and statistical problems.
+//       Journal of the ACM, 8(2):212-229; 1961. doi:10.1145/321062.321069
+func (o *Optimiser) lsHookeJeeves(A *Solution) (nfeval int) {
+	n := o.Nflt
+	step := o.lsPool[1].Flt
+	for i := 0; i < n; i++ {
+		step[i] = o.LsStep * o.DelFlt[i]
+	}
+	base, P, T := A, o.lsPool[2], o.lsPool[3]
+	for nfeval < o.LsNfeval {
+
+		// exploratory moves around base
+		base.CopyInto(P)
+		nfeval += o.lsExplore(P, A, step)
+		if !o.lsBetter(P, base) {
+			if o.lsSmallStep(step) {
+				break
+			}
+			for i := 0; i < n; i++ {
+				step[i] /= 2.0
+			}
+			continue
+		}
+
+		// pattern moves while successful
+		for nfeval < o.LsNfeval {
+			for i := 0; i < n; i++ {
+				T.Flt[i] = 2.0*P.Flt[i] - base.Flt[i]
+			}
+			P.CopyInto(base)
+			o.lsEval(T, A, T.Flt)
+			nfeval++
+			nfeval += o.lsExplore(T, A, step)
+			if !o.lsBetter(T, base) {
+				break
+			}
+			T.CopyInto(P)
+		}
+	}
+	if o.lsBetter(P, A) { // budget exhausted during pattern moves
+		P.CopyInto(A)
+	}
+	return
+}
+
+// lsCoordSearch runs the coordinate (compass) search starting at A
+//  Output: A becomes the best point and number of function evaluations
+func (o *Optimiser) lsCoordSearch(A *Solution) (nfeval int) {
+	n := o.Nflt
+	step := o.lsPool[1].Flt
+	for i := 0; i < n; i++ {
+		step[i] = o.LsStep * o.DelFlt[i]
+	}
+	for nfeval < o.LsNfeval {
+		A.CopyInto(o.lsPool[2])
+		nfeval += o.lsExplore(A, A, step)
+		if o.lsBetter(A, o.lsPool[2]) {
+			continue
+		}
+		if o.lsSmallStep(step) {
+			break
+		}
+		for i := 0; i < n; i++ {
+			step[i] /= 2.0
+		}
+	}
+	return
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// lsExplore performs exploratory moves of size step along each coordinate of P
+//  ref -- solution providing the ints
+//  Output: P becomes the best point and number of function evaluations
+func (o *Optimiser) lsExplore(P, ref *Solution, step []float64) (nfeval int) {
+	T := o.lsPool[4]
+	for i := 0; i < o.Nflt && nfeval < o.LsNfeval; i++ {
+		for _, sgn := range []float64{+1, -1} {
+			copy(T.Flt, P.Flt)
+			T.Flt[i] += sgn * step[i]
+			o.lsEval(T, ref, T.Flt)
+			nfeval++
+			if o.lsBetter(T, P) {
+				T.CopyInto(P)
+				break
+			}
+		}
+	}
+	return
+}
+
+// lsEval evaluates trial solution T with floats x and ints of ref
+//  Note: x is brought back into [FltMin,FltMax] according to FltBry/FltBryVar (with ref as
+//        parent) and snapped to discrete values; x may be T.Flt
+func (o *Optimiser) lsEval(T, ref *Solution, x []float64) {
+	T.Id = ref.Id
+	for i := 0; i < o.Nflt; i++ {
+		T.Flt[i] = o.FltBoundary(i, x[i], ref.Flt[i], o.FltMin[i], o.FltMax[i])
+	}
+	o.SnapFlt(T.Flt)
+	copy(T.Int, ref.Int)
+	o.ObjFunc(T, 0)
+}
+
+// lsBetter tells whether A is better than B: constraint violation first, then objective values
+// in lexicographic order or normalised sum of objective values
+func (o *Optimiser) lsBetter(A, B *Solution) bool {
+	va, vb := A.Violation(), B.Violation()
+	if va > 0 || vb > 0 {
+		return va < vb
+	}
+	if o.Lexico {
+		A_wins, _ := o.LexCompare(A.Ova, B.Ova)
+		return A_wins
+	}
+	if o.Nova < 2 {
+		return A.Ova[0] < B.Ova[0]
+	}
+	fa, fb := 0.0, 0.0
+	for j := 0; j < o.Nova; j++ {
+		δ := o.Metrics.Omax[j] - o.Metrics.Omin[j] + 1e-15
+		fa += A.Ova[j] / δ
+		fb += B.Ova[j] / δ
+	}
+	return fa < fb
+}
+
+// lsSort sorts vertices of simplex from best to worst (insertion sort)
+func (o *Optimiser) lsSort(S []*Solution) {
+	for i := 1; i < len(S); i++ {
+		for j := i; j > 0 && o.lsBetter(S[j], S[j-1]); j-- {
+			S[j], S[j-1] = S[j-1], S[j]
+		}
+	}
+}
+
+// lsSize returns the size of simplex relative to FltMax-FltMin
+func (o *Optimiser) lsSize(S []*Solution) (size float64) {
+	for k := 1; k < len(S); k++ {
+		for i := 0; i < o.Nflt; i++ {
+			size = math.Max(size, math.Abs(S[k].Flt[i]-S[0].Flt[i])/(o.DelFlt[i]+1e-15))
+		}
+	}
+	return
+}
+
+// lsSmallStep tells whether all steps are smaller than LsTol relative to FltMax-FltMin
+func (o *Optimiser) lsSmallStep(step []float64) bool {
+	for i := 0; i < o.Nflt; i++ {
+		if step[i] >= o.LsTol*o.DelFlt[i] {
+			return false
+		}
+	}
+	return true
+}
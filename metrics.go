@@ -78,10 +78,10 @@ func (o *Metrics) Compute(sols []*Solution) (nfronts int) {
 			}
 		}
 
-		// floats range
-		for j := 0; j < o.prms.Nflt; j++ {
-			x := sol.Flt[j]
-			if i == 0 {
+		// floats range (all genes in case of variable-length chromosomes)
+		for k, x := range sol.Flt {
+			j := k % o.prms.Nflt
+			if i == 0 && k == j {
 				o.Fmin[j] = x
 				o.Fmax[j] = x
 			} else {
@@ -90,10 +90,10 @@ func (o *Metrics) Compute(sols []*Solution) (nfronts int) {
 			}
 		}
 
-		// ints range
-		for j := 0; j < o.prms.Nint; j++ {
-			x := sol.Int[j]
-			if i == 0 {
+		// ints range (all genes in case of variable-length chromosomes)
+		for k, x := range sol.Int {
+			j := k % o.prms.Nint
+			if i == 0 && k == j {
 				o.Imin[j] = x
 				o.Imax[j] = x
 			} else {
@@ -103,6 +103,17 @@ func (o *Metrics) Compute(sols []*Solution) (nfronts int) {
 		}
 	}
 
+	// buckets of objective values for lexicographic comparisons
+	if o.prms.Lexico {
+		F := make([][]float64, nsol)
+		for i, sol := range sols {
+			F[i] = sol.Ova
+		}
+		for i, B := range o.prms.LexBuckets(F) {
+			sols[i].LexBkt = append(sols[i].LexBkt[:0], B...)
+		}
+	}
+
 	// compute neighbour distance
 	for i := 0; i < nsol; i++ {
 		A := sols[i]
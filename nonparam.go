@@ -0,0 +1,408 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/utl"
+)
+
+// RankSum performs the two-sided Wilcoxon rank-sum (Mann-Whitney U) test on two independent
+// samples; e.g. the best objective values of two optimisers with different parameters
+//  U -- Mann-Whitney statistic of x; i.e. the number of pairs with x[i] > y[j] (ties count 1/2)
+//  p -- p-value of the null hypothesis that x and y come from the same distribution
+//  Note: the exact distribution is used if there are no ties and both samples have less than 50
+//        values; otherwise, the normal approximation with tie and continuity corrections is used
+func RankSum(x, y []float64) (U, p float64) {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		chk.Panic("samples of rank-sum test must not be empty. len(x)=%d, len(y)=%d", n1, n2)
+	}
+	r, ties := ranks(append(append([]float64{}, x...), y...))
+	R1 := 0.0
+	for i := 0; i < n1; i++ {
+		R1 += r[i]
+	}
+	U = R1 - float64(n1*(n1+1))/2
+
+	// exact
+	if ties == 0 && n1 < 50 && n2 < 50 {
+		return U, exactTwoSided(rankSumCounts(n1, n2), int(U))
+	}
+
+	// normal approximation
+	N := float64(n1 + n2)
+	μ := float64(n1*n2) / 2
+	σ := math.Sqrt(float64(n1*n2) / 12 * ((N + 1) - ties/(N*(N-1))))
+	return U, normTwoSided(U, μ, σ)
+}
+
+// SignedRank performs the two-sided Wilcoxon signed-rank test on paired samples; e.g. the best
+// objective values of two optimisers with the same seeds in each trial
+//  W -- sum of ranks of positive differences x[i] - y[i]
+//  p -- p-value of the null hypothesis that the differences are symmetric about zero
+//  Note: zero differences are discarded. The exact distribution is used if there are no ties or
+//        zeros and less than 50 pairs; otherwise, the normal approximation is used
+func SignedRank(x, y []float64) (W, p float64) {
+	if len(x) != len(y) {
+		chk.Panic("samples of signed-rank test must have the same length. %d != %d", len(x), len(y))
+	}
+	var d []float64
+	for i := range x {
+		if x[i] != y[i] {
+			d = append(d, x[i]-y[i])
+		}
+	}
+	n := len(d)
+	if n == 0 {
+		return 0, 1
+	}
+	absd := make([]float64, n)
+	for i, v := range d {
+		absd[i] = math.Abs(v)
+	}
+	r, ties := ranks(absd)
+	for i, v := range d {
+		if v > 0 {
+			W += r[i]
+		}
+	}
+
+	// exact
+	if ties == 0 && n == len(x) && n < 50 {
+		return W, exactTwoSided(signedRankCounts(n), int(W))
+	}
+
+	// normal approximation
+	N := float64(n)
+	μ := N * (N + 1) / 4
+	σ := math.Sqrt(N*(N+1)*(2*N+1)/24 - ties/48)
+	return W, normTwoSided(W, μ, σ)
+}
+
+// KruskalWallis performs the Kruskal-Wallis H test on many independent samples; e.g. the best
+// objective values of many optimisers
+//  H -- statistic corrected for ties
+//  p -- p-value of the null hypothesis that all samples come from the same distribution
+//       (chi-squared approximation with len(samples)-1 degrees of freedom)
+func KruskalWallis(samples [][]float64) (H, p float64) {
+	k := len(samples)
+	if k < 2 {
+		chk.Panic("Kruskal-Wallis test requires at least 2 samples. %d is invalid", k)
+	}
+	var all []float64
+	for _, s := range samples {
+		all = append(all, s...)
+	}
+	r, ties := ranks(all)
+	N := float64(len(all))
+	start := 0
+	for _, s := range samples {
+		R := 0.0
+		for i := range s {
+			R += r[start+i]
+		}
+		start += len(s)
+		H += R * R / float64(len(s))
+	}
+	H = 12/(N*(N+1))*H - 3*(N+1)
+	if ties > 0 {
+		H /= 1 - ties/(N*N*N-N)
+	}
+	return H, chi2Sf(H, k-1)
+}
+
+// Friedman performs the Friedman test on blocks of related samples; e.g. the average values of
+// many optimisers (treatments) solving the same problems (blocks)
+//  data -- [nblocks][ntreatments] values. smaller values receive smaller ranks
+//  Q    -- statistic corrected for ties
+//  p    -- p-value of the null hypothesis that all treatments are equivalent (chi-squared
+//          approximation with ntreatments-1 degrees of freedom)
+//  R    -- [ntreatments] average ranks
+func Friedman(data [][]float64) (Q, p float64, R []float64) {
+	N, k := len(data), 0
+	if N > 0 {
+		k = len(data[0])
+	}
+	if N < 2 || k < 2 {
+		chk.Panic("Friedman test requires at least 2 blocks and 2 treatments. nblocks=%d and ntreatments=%d are invalid", N, k)
+	}
+	R = make([]float64, k)
+	ties := 0.0
+	for _, block := range data {
+		r, t := ranks(block)
+		for j := range R {
+			R[j] += r[j]
+		}
+		ties += t
+	}
+	for _, Rj := range R {
+		Q += Rj * Rj
+	}
+	n, K := float64(N), float64(k)
+	Q = 12/(n*K*(K+1))*Q - 3*n*(K+1)
+	if ties > 0 {
+		Q /= 1 - ties/(n*(K*K*K-K))
+	}
+	for j := range R {
+		R[j] /= n
+	}
+	return Q, chi2Sf(Q, k-1), R
+}
+
+// PostHocNemenyi performs the Nemenyi post-hoc test after the Friedman test
+//  data -- [nblocks][ntreatments] values. see Friedman
+//  P    -- [ntreatments][ntreatments] p-values of pairwise comparisons
+func PostHocNemenyi(data [][]float64) (P [][]float64) {
+	Z := friedmanPairs(data)
+	k := len(Z)
+	P = make([][]float64, k)
+	for i := 0; i < k; i++ {
+		P[i] = make([]float64, k)
+		for j := 0; j < k; j++ {
+			P[i][j] = 1 - studRangeCdf(Z[i][j]*math.Sqrt2, k)
+		}
+	}
+	return
+}
+
+// PostHocHolm performs pairwise comparisons after the Friedman test with the z-statistics of the
+// average ranks and the Holm correction of p-values
+//  data -- [nblocks][ntreatments] values. see Friedman
+//  P    -- [ntreatments][ntreatments] adjusted p-values of pairwise comparisons
+func PostHocHolm(data [][]float64) (P [][]float64) {
+	Z := friedmanPairs(data)
+	k := len(Z)
+	var p []float64
+	for i := 0; i < k; i++ {
+		for j := i + 1; j < k; j++ {
+			p = append(p, math.Erfc(Z[i][j]/math.Sqrt2))
+		}
+	}
+	return pairMatrix(k, Holm(p))
+}
+
+// Holm returns the p-values adjusted with the Holm-Bonferroni step-down method to control the
+// family-wise error rate of multiple comparisons
+func Holm(p []float64) (adj []float64) {
+	m := len(p)
+	idx := make([]int, m)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return p[idx[a]] < p[idx[b]] })
+	adj = make([]float64, m)
+	prev := 0.0
+	for i, k := range idx {
+		prev = math.Max(prev, math.Min(1, float64(m-i)*p[k]))
+		adj[k] = prev
+	}
+	return
+}
+
+// A12 computes the Vargha-Delaney effect size; i.e. the probability that a value of x is greater
+// than a value of y (ties count 1/2). 0.5 means no effect. For minimisation, A12 < 0.5 means that
+// x tends to be better than y
+func A12(x, y []float64) (a float64) {
+	for _, u := range x {
+		for _, v := range y {
+			if u > v {
+				a++
+			} else if u == v {
+				a += 0.5
+			}
+		}
+	}
+	return a / float64(len(x)*len(y))
+}
+
+// A12Magnitude returns the magnitude of the Vargha-Delaney effect size: "negligible", "small",
+// "medium" or "large" with the thresholds 0.56, 0.64 and 0.71 of |A12 - 0.5| + 0.5
+func A12Magnitude(a float64) string {
+	d := math.Abs(a-0.5) + 0.5
+	switch {
+	case d < 0.56:
+		return "negligible"
+	case d < 0.64:
+		return "small"
+	case d < 0.71:
+		return "medium"
+	}
+	return "large"
+}
+
+// auxiliary ///////////////////////////////////////////////////////////////////////////////////////
+
+// ranks returns the ranks (1 to n) of values with ties receiving the average rank
+//  ties -- Σ (t³ - t) over groups of t tied values
+func ranks(v []float64) (r []float64, ties float64) {
+	n := len(v)
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return v[idx[a]] < v[idx[b]] })
+	r = make([]float64, n)
+	for i := 0; i < n; {
+		j := i
+		for j+1 < n && v[idx[j+1]] == v[idx[i]] {
+			j++
+		}
+		for k := i; k <= j; k++ {
+			r[idx[k]] = float64(i+j)/2 + 1
+		}
+		t := float64(j - i + 1)
+		ties += t*t*t - t
+		i = j + 1
+	}
+	return
+}
+
+// rankSumCounts returns the number of arrangements of n1 and n2 values for each U statistic
+func rankSumCounts(n1, n2 int) []float64 {
+	// c[k][s] = number of subsets of size k of the ranks considered so far with sum of ranks s,
+	// where s is shifted such that U = s - k(k+1)/2
+	umax := n1 * n2
+	c := make([][]float64, n1+1)
+	for k := range c {
+		c[k] = make([]float64, umax+1)
+	}
+	c[0][0] = 1
+	for m := 1; m <= n1+n2; m++ { // add rank m: the k-th selected rank m adds m-k to U
+		for k := utl.Imin(m, n1); k >= 1; k-- {
+			du := m - k
+			if du > n2 {
+				continue
+			}
+			for u := umax; u >= du; u-- {
+				c[k][u] += c[k-1][u-du]
+			}
+		}
+	}
+	return c[n1]
+}
+
+// signedRankCounts returns the number of subsets of {1, ..., n} for each sum
+func signedRankCounts(n int) []float64 {
+	c := make([]float64, n*(n+1)/2+1)
+	c[0] = 1
+	for m := 1; m <= n; m++ {
+		for s := len(c) - 1; s >= m; s-- {
+			c[s] += c[s-m]
+		}
+	}
+	return c
+}
+
+// exactTwoSided returns the two-sided p-value 2 min(P(X ≤ x), P(X ≥ x)) given counts of outcomes
+func exactTwoSided(counts []float64, x int) float64 {
+	total, lo, hi := 0.0, 0.0, 0.0
+	for i, c := range counts {
+		total += c
+		if i <= x {
+			lo += c
+		}
+		if i >= x {
+			hi += c
+		}
+	}
+	return math.Min(1, 2*math.Min(lo, hi)/total)
+}
+
+// normTwoSided returns the two-sided p-value of the normal approximation with continuity correction
+func normTwoSided(x, μ, σ float64) float64 {
+	if σ <= 0 {
+		return 1
+	}
+	z := math.Max(0, math.Abs(x-μ)-0.5) / σ
+	return math.Erfc(z / math.Sqrt2)
+}
+
+// chi2Sf computes the survival function 1 - CDF of the chi-squared distribution with ν degrees of
+// freedom
+func chi2Sf(x float64, ν int) (res float64) {
+	if x <= 0 {
+		return 1
+	}
+	if ν%2 == 0 {
+		term := math.Exp(-x / 2)
+		for i := 0; i < ν/2; i++ {
+			if i > 0 {
+				term *= x / 2 / float64(i)
+			}
+			res += term
+		}
+		return math.Min(1, res)
+	}
+	res = math.Erfc(math.Sqrt(x / 2))
+	term := math.Sqrt(x)
+	φ := math.Exp(-x/2) / math.Sqrt(2*math.Pi)
+	for r := 1; r <= (ν-1)/2; r++ {
+		if r > 1 {
+			term *= x / float64(2*r-1)
+		}
+		res += 2 * φ * term
+	}
+	return math.Min(1, res)
+}
+
+// studRangeCdf computes the CDF of the studentized range of k normal variables with infinite
+// degrees of freedom: k ∫ φ(z) [Φ(z) - Φ(z-q)]^(k-1) dz
+func studRangeCdf(q float64, k int) float64 {
+	if q <= 0 {
+		return 0
+	}
+	Φ := func(z float64) float64 { return 0.5 * math.Erfc(-z/math.Sqrt2) }
+	f := func(z float64) float64 {
+		return math.Exp(-z*z/2) / math.Sqrt(2*math.Pi) * math.Pow(Φ(z)-Φ(z-q), float64(k-1))
+	}
+	a, b, n := -8.0, 8.0+q, 2000 // Simpson's rule
+	h := (b - a) / float64(n)
+	sum := f(a) + f(b)
+	for i := 1; i < n; i++ {
+		if i%2 == 1 {
+			sum += 4 * f(a+float64(i)*h)
+		} else {
+			sum += 2 * f(a+float64(i)*h)
+		}
+	}
+	return math.Min(1, float64(k)*sum*h/3)
+}
+
+// friedmanPairs returns the z-statistics |R[i] - R[j]| / sqrt(k(k+1)/(6N)) of average ranks
+func friedmanPairs(data [][]float64) (Z [][]float64) {
+	_, _, R := Friedman(data)
+	k, N := float64(len(R)), float64(len(data))
+	se := math.Sqrt(k * (k + 1) / (6 * N))
+	Z = make([][]float64, len(R))
+	for i := range R {
+		Z[i] = make([]float64, len(R))
+		for j := range R {
+			Z[i][j] = math.Abs(R[i]-R[j]) / se
+		}
+	}
+	return
+}
+
+// pairMatrix returns a symmetric matrix [k][k] with ones on the diagonal given the values of the
+// pairs (0,1), (0,2), ..., (1,2), ...
+func pairMatrix(k int, p []float64) (P [][]float64) {
+	P = make([][]float64, k)
+	for i := range P {
+		P[i] = make([]float64, k)
+		P[i][i] = 1
+	}
+	idx := 0
+	for i := 0; i < k; i++ {
+		for j := i + 1; j < k; j++ {
+			P[i][j], P[j][i] = p[idx], p[idx]
+			idx++
+		}
+	}
+	return
+}
@@ -4,9 +4,14 @@
 
 package goga
 
-import "github.com/cpmech/gosl/rnd"
+import (
+	"math"
+
+	"github.com/cpmech/gosl/rnd"
+)
 
 // DiffEvol performs the differential-evolution operation
+//  Note: out-of-range values are handled according to FltBry and FltBryVar
 func DiffEvol(xnew, x, x0, x1, x2 []float64, prms *Parameters) {
 
 	// normalise variables
@@ -20,19 +25,9 @@ func DiffEvol(xnew, x, x0, x1, x2 []float64, prms *Parameters) {
 		if rnd.FlipCoin(prms.DEC) || i == I {
 			xnew[i] = r0[i] + F*(r1[i]-r2[i])
 			if prms.NormFlt {
-				if xnew[i] < 0 {
-					xnew[i] = 0
-				}
-				if xnew[i] > 1 {
-					xnew[i] = 1
-				}
+				xnew[i] = prms.FltBoundary(i, xnew[i], r[i], 0, 1)
 			} else {
-				if xnew[i] < prms.FltMin[i] {
-					xnew[i] = prms.FltMin[i]
-				}
-				if xnew[i] > prms.FltMax[i] {
-					xnew[i] = prms.FltMax[i]
-				}
+				xnew[i] = prms.FltBoundary(i, xnew[i], r[i], prms.FltMin[i], prms.FltMax[i])
 			}
 		} else {
 			xnew[i] = r[i]
@@ -42,3 +37,31 @@ func DiffEvol(xnew, x, x0, x1, x2 []float64, prms *Parameters) {
 	// de-normalise result
 	prms.DeNormalise1(xnew)
 }
+
+// DiffEvolInt performs the discrete differential-evolution operation with ints
+//  Note: the difference vector is rounded to the nearest integer and results are kept within
+//        [IntMin, IntMax]. Categorical genes are taken from a0 if a1 and a2 agree or randomly
+//        reset otherwise
+func DiffEvolInt(anew, a, a0, a1, a2 []int, prms *Parameters) {
+	n := len(anew)
+	if n < 1 {
+		return
+	}
+	F := rnd.Float64(0.0, 1.0)
+	I := rnd.Int(0, n-1)
+	for i := 0; i < n; i++ {
+		if rnd.FlipCoin(prms.DEC) || i == I {
+			if prms.IsCat(i) {
+				if a1[i] == a2[i] {
+					anew[i] = a0[i]
+				} else {
+					anew[i] = rnd.Int(prms.IntMin[i], prms.IntMax[i])
+				}
+				continue
+			}
+			anew[i] = prms.EnforceIntRange(i, a0[i]+int(math.Floor(F*float64(a1[i]-a2[i])+0.5)))
+		} else {
+			anew[i] = a[i]
+		}
+	}
+}
@@ -16,9 +16,12 @@ import (
 	"github.com/cpmech/gosl/utl"
 )
 
+// Mesh holds the Delaunay triangulation of (xi,xj) points of all solutions
+//  Note: vertex k corresponds to Solutions[k]
 type Mesh struct {
-	V [][]float64 // vertices
-	C [][]int     // cells
+	V  [][]float64 // vertices
+	C  [][]int     // cells
+	Vc [][]int     // [nverts] cells attached to each vertex
 }
 
 // Optimiser solves optimisation problems:
@@ -41,12 +44,30 @@ type Mesh struct {
 type Optimiser struct {
 
 	// input
-	Parameters           // input parameters
-	ObjFunc    ObjFunc_t // [optional] objective function
-	MinProb    MinProb_t // [optional] minimisation problem function
-	CxInt      CxInt_t   // [optional] crossover function for ints
-	MtInt      MtInt_t   // [optional] mutation function for ints
-	Output     Output_t  // [optional] output function
+	Parameters               // input parameters
+	ObjFunc    ObjFunc_t     // [optional] objective function
+	MinProb    MinProb_t     // [optional] minimisation problem function
+	MinGrad    MinProbGrad_t // [optional] gradients of minimisation problem functions => polishing after Solve
+	CxInt      CxInt_t       // [optional] crossover function for ints. default depends on BinInt and PermInt
+	MtInt      MtInt_t       // [optional] mutation function for ints. default depends on BinInt and PermInt
+	Output     Output_t      // [optional] output function
+
+	// input: seeding
+	SeedFlt [][]float64 // [optional] [nseed][nflt] floats of solutions seeding the initial population. [nseed][ngene*nflt] with VarLen
+	SeedInt [][]int     // [optional] [nseed][nint] ints of solutions seeding the initial population. [nseed][ngene*nint] with VarLen
+
+	// input: permutations
+	DistMat [][]float64 // [optional] distance matrix for 2-opt/Or-opt improvement of permutations
+
+	// results: polishing
+	Polished PolishRes // results of gradient-based polishing after Solve (if MinGrad != nil)
+
+	// results: time-varying parameters
+	SchedTimes []int                // output times when the scheduled parameters were recorded
+	SchedVals  map[string][]float64 // effective values of scheduled parameters at SchedTimes
+
+	// results: convergence
+	History *History // convergence history of last run (if ConvHist != "")
 
 	// essential
 	Generator Generator_t // generate solutions
@@ -55,7 +76,8 @@ type Optimiser struct {
 	Metrics   *Metrics    // metrics
 
 	// meshes
-	Meshes [][]*Mesh // meshes for (xi,xj) points. [nflt-1][nflt] only upper diagonal entries
+	Meshes [][]*Mesh         // meshes for (xi,xj) points. [nflt-1][nflt] only upper diagonal entries
+	mshIdx map[*Solution]int // indices of vertices of solutions in meshes
 
 	// auxiliary
 	Stat                   // structure holding stat data
@@ -63,6 +85,8 @@ type Optimiser struct {
 	F, G, H    [][]float64 // [cpu] temporary
 	tmp        *Solution   // temporary solution
 	cpupairs   [][]int     // pairs of CPU ids. for exchanging solutions
+	lsElite    []*Solution // elite solutions for local search
+	lsPool     []*Solution // trial solutions for local search
 	iova0      int         // index of current item in ova[0]
 	ova0       []float64   // last ova[0] values to assess convergence
 }
@@ -70,14 +94,52 @@ type Optimiser struct {
 // Initialises continues initialisation by generating individuals
 //  Optional:  obj  XOR  fcn, nf, ng, nh
 func (o *Optimiser) Init(gen Generator_t, obj ObjFunc_t, fcn MinProb_t, nf, ng, nh int) {
+	err := o.setup(gen, obj, fcn, nf, ng, nh)
+	if err != nil {
+		chk.Panic("%v", err)
+	}
+	o.generate_solutions(0)
+}
+
+// InitErr is a variant of Init that returns errors instead of panicking
+//  Note: all problems found by Validate are reported at once. Other configuration errors
+//        (e.g. reading SeedFile, invalid seeds or missing reference points of Multi_indKeys)
+//        are also returned. Panics during the generation of initial solutions (e.g. in the
+//        objective function with GenAll) are returned as generation errors
+func (o *Optimiser) InitErr(gen Generator_t, obj ObjFunc_t, fcn MinProb_t, nf, ng, nh int) (err error) {
+	err = o.setup(gen, obj, fcn, nf, ng, nh)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = chk.Err("generation of initial solutions failed:\n%v", r)
+		}
+	}()
+	o.generate_solutions(0)
+	return
+}
+
+// setup sets the problem, computes derived parameters and allocates solutions; i.e. all steps
+// of Init but the generation of solutions
+func (o *Optimiser) setup(gen Generator_t, obj ObjFunc_t, fcn MinProb_t, nf, ng, nh int) (err error) {
+
+	// check parameters
+	if obj == nil && fcn == nil {
+		return chk.Err("either ObjFunc or MinProb must be provided")
+	}
+	if obj == nil {
+		o.Nova = nf
+	}
+	err = o.Validate()
+	if err != nil {
+		return
+	}
 
 	// generic or minimisation problem
 	if obj != nil {
 		o.ObjFunc = obj
 	} else {
-		if fcn == nil {
-			chk.Panic("either ObjFunc or MinProb must be provided")
-		}
 		o.Nf, o.Ng, o.Nh, o.MinProb = nf, ng, nh, fcn
 		o.ObjFunc = func(sol *Solution, cpu int) {
 			o.MinProb(o.F[cpu], o.G[cpu], o.H[cpu], sol.Flt, sol.Int, cpu)
@@ -87,10 +149,12 @@ func (o *Optimiser) Init(gen Generator_t, obj ObjFunc_t, fcn MinProb_t, nf, ng,
 			for i, g := range o.G[cpu] {
 				sol.Oor[i] = utl.GtePenalty(g, 0.0, 1) // g[i] ≥ 0
 			}
+			sol.Habs = sol.Habs[:0]
 			for i, h := range o.H[cpu] {
 				h = math.Abs(h)
 				sol.Ova[0] += h
 				sol.Oor[o.Ng+i] = utl.GtePenalty(o.EpsH, h, 1) // ϵ ≥ |h[i]|
+				sol.Habs = append(sol.Habs, h)
 			}
 		}
 		o.F = la.MatAlloc(o.Ncpu, o.Nf)
@@ -100,10 +164,67 @@ func (o *Optimiser) Init(gen Generator_t, obj ObjFunc_t, fcn MinProb_t, nf, ng,
 		o.Noor = o.Ng + o.Nh
 	}
 
+	// buckets of lexicographic comparisons are out of date once objective values are recomputed
+	// (e.g. copies evaluated by local search or polishing); they are set again by Metrics.Compute
+	if o.Lexico {
+		objfunc := o.ObjFunc
+		o.ObjFunc = func(sol *Solution, cpu int) {
+			objfunc(sol, cpu)
+			sol.LexBkt = sol.LexBkt[:0]
+		}
+	}
+
 	// calc derived parameters
 	o.Generator = gen
 	o.CalcDerived()
 
+	// polishing
+	if o.MinGrad != nil && o.PolNit > 0 {
+		if o.MinProb == nil || o.Nf != 1 || o.Nflt < 1 || o.VarLen || o.FltBits > 0 {
+			return chk.Err("polishing (MinGrad) requires a single-objective MinProb with fixed-length floats that are not binary encoded")
+		}
+	}
+
+	// improvement of permutations
+	if o.Perm2opt || o.PermOrOp {
+		valid := len(o.DistMat) == o.PermInt
+		for _, row := range o.DistMat {
+			if len(row) != o.PermInt {
+				valid = false
+			}
+		}
+		if !valid {
+			return chk.Err("improvement of permutations (Perm2opt or PermOrOp) requires a distance matrix DistMat with size %d×%d", o.PermInt, o.PermInt)
+		}
+	}
+
+	// operators for ints
+	if o.Nint > 0 {
+		switch {
+		case o.PermInt > 0:
+			if o.CxInt == nil {
+				o.CxInt = CxIntOrd
+			}
+			if o.MtInt == nil {
+				o.MtInt = MtIntOrd
+			}
+		case o.BinInt > 0:
+			if o.CxInt == nil {
+				o.CxInt = CxInt
+			}
+			if o.MtInt == nil {
+				o.MtInt = MtIntBin
+			}
+		default:
+			if o.CxInt == nil {
+				o.CxInt = CxInt
+			}
+			if o.MtInt == nil {
+				o.MtInt = MtIntRnd
+			}
+		}
+	}
+
 	// allocate solutions
 	o.Solutions = NewSolutions(o.Nsol, &o.Parameters)
 	o.Groups = make([]*Group, o.Ncpu)
@@ -122,8 +243,50 @@ func (o *Optimiser) Init(gen Generator_t, obj ObjFunc_t, fcn MinProb_t, nf, ng,
 	o.iova0 = -1
 	o.ova0 = make([]float64, o.Tf)
 
-	// generate trial solutions
-	o.generate_solutions(0)
+	// seeds from file
+	if o.SeedFile != "" {
+		flt, ints, err := ReadAllValues(o.SeedFile, &o.Parameters)
+		if err != nil {
+			return chk.Err("cannot read seeds:\n%v", err)
+		}
+		o.SeedFlt = append(o.SeedFlt, flt...)
+		o.SeedInt = append(o.SeedInt, ints...)
+	}
+	if o.VarLen {
+		err = o.check_varlen_seeds()
+		if err != nil {
+			return
+		}
+	}
+	for k, x := range o.SeedFlt {
+		if len(x) != o.Nflt && !o.VarLen {
+			return chk.Err("seed %d must have %d floats. len(SeedFlt[%d]) = %d is invalid", k, o.Nflt, k, len(x))
+		}
+	}
+	if o.FltBits == 0 {
+		for k, y := range o.SeedInt {
+			if len(y) != o.Nint && !o.VarLen {
+				return chk.Err("seed %d must have %d ints. len(SeedInt[%d]) = %d is invalid", k, o.Nint, k, len(y))
+			}
+			if o.PermInt > 0 && !IsPerm(y) {
+				return chk.Err("seeded ints must be a permutation. SeedInt[%d] = %v is invalid", k, y)
+			}
+		}
+	}
+
+	// quality indicators
+	err = o.check_indicators()
+	if err != nil {
+		return
+	}
+
+	// local search
+	if o.LsType != "" {
+		o.lsElite = make([]*Solution, o.Nsol)
+		o.lsPool = NewSolutions(o.Nflt+5, &o.Parameters)
+	}
+
+	return
 }
 
 // GetSolutionsCopy returns a copy of Solutions
@@ -147,6 +310,19 @@ func (o *Optimiser) Solve() {
 		}()
 	}
 
+	// time-varying parameters
+	o.SchedTimes, o.SchedVals = nil, nil
+	o.sched_update(0)
+
+	// convergence history
+	if o.ConvHist != "" {
+		if err := o.check_indicators(); err != nil {
+			chk.Panic("%v", err)
+		}
+	}
+	o.History = nil
+	o.hist_update(0)
+
 	// output
 	if o.Output != nil {
 		o.Output(0, o.Solutions)
@@ -156,6 +332,8 @@ func (o *Optimiser) Solve() {
 	done := make(chan int, o.Ncpu)
 	time := 0
 	texc := time + o.DtExc
+	tmsh := time + o.DtMsh
+	tout := time + utl.Imax(1, o.DtOut)
 	for time < o.Tf {
 
 		// run groups in parallel. up to exchange time
@@ -200,6 +378,9 @@ func (o *Optimiser) Solve() {
 					k := rnd.Int(0, n)
 					A := o.Groups[i].All[k]
 					B := o.Groups[j].All[k]
+					if A.Fixed || B.Fixed {
+						continue
+					}
 					B.CopyInto(o.tmp)
 					A.CopyInto(B)
 					o.tmp.CopyInto(A)
@@ -207,17 +388,47 @@ func (o *Optimiser) Solve() {
 			}
 		}
 
+		// local search
+		if o.LsType != "" {
+			o.Nfeval += o.LocalSearch()
+		}
+
 		// update time variables
 		time += o.DtExc
 		texc += o.DtExc
 		time = utl.Imin(time, o.Tf)
 		texc = utl.Imin(texc, o.Tf)
 
+		// re-triangulation
+		if o.Meshes != nil && time >= tmsh && time < o.Tf {
+			o.build_meshes(false)
+			tmsh = time + o.DtMsh
+		}
+
+		// time-varying parameters
+		o.sched_update(time)
+
+		// convergence history: at the first exchange time at or after each multiple of DtOut
+		if o.ConvHist == "exc" || time >= tout || time == o.Tf {
+			o.hist_update(time)
+			for tout <= time {
+				tout += utl.Imax(1, o.DtOut)
+			}
+		}
+
 		// output
 		if o.Output != nil {
 			o.Output(time, o.Solutions)
 		}
 	}
+
+	// gradient-based polishing
+	if o.MinGrad != nil && o.PolNit > 0 {
+		o.Polish()
+		if o.Verbose {
+			io.Pf("\n%v\n", o.Polished)
+		}
+	}
 }
 
 // EvolveOneGroup evolves one group (CPU)
@@ -252,15 +463,58 @@ func (o *Optimiser) EvolveOneGroup(cpu int) (nfeval int) {
 		a := G[z+P[k][0]]
 		b := G[z+P[k][1]]
 
-		if o.Nflt > 0 {
+		if o.VarLen {
+			CxVarLen(a, b, A, B, &o.Parameters)
+			DiffEvolVarLen(a, A0, A1, A2, &o.Parameters)
+			DiffEvolVarLen(b, B0, B1, B2, &o.Parameters)
+			MtVarLen(a, &o.Parameters)
+			MtVarLen(b, &o.Parameters)
+			o.ObjFunc(a, cpu)
+			o.ObjFunc(b, cpu)
+			nfeval += 2
+			continue
+		}
+
+		if o.Nflt > 0 && o.FltBits == 0 {
 			DiffEvol(a.Flt, A.Flt, A0.Flt, A1.Flt, A2.Flt, &o.Parameters)
 			DiffEvol(b.Flt, B.Flt, B0.Flt, B1.Flt, B2.Flt, &o.Parameters)
+			if o.Meshes != nil {
+				o.mesh_move(a, A)
+				o.mesh_move(b, B)
+			}
+			o.SnapFlt(a.Flt)
+			o.SnapFlt(b.Flt)
 		}
 
-		if o.Nint > 0 {
+		if o.Nint > 0 && o.IntDE {
+			DiffEvolInt(a.Int, A.Int, A0.Int, A1.Int, A2.Int, &o.Parameters)
+			DiffEvolInt(b.Int, B.Int, B0.Int, B1.Int, B2.Int, &o.Parameters)
+		} else if o.Nint > 0 {
 			o.CxInt(a.Int, b.Int, A.Int, B.Int, &o.Parameters)
 			o.MtInt(a.Int, &o.Parameters)
 			o.MtInt(b.Int, &o.Parameters)
+			if o.PermInt > 0 {
+				RepairPerm(a.Int) // user-defined operators may produce invalid permutations
+				RepairPerm(b.Int)
+				if o.Perm2opt {
+					TwoOpt(a.Int, o.DistMat)
+					TwoOpt(b.Int, o.DistMat)
+				}
+				if o.PermOrOp {
+					OrOpt(a.Int, o.DistMat)
+					OrOpt(b.Int, o.DistMat)
+				}
+			}
+			if o.FltBits > 0 {
+				o.DecodeFlt(a.Flt, a.Int)
+				o.DecodeFlt(b.Flt, b.Int)
+			}
+			if o.BinInt == 0 && o.PermInt == 0 {
+				for i := 0; i < o.Nint; i++ {
+					a.Int[i] = o.EnforceIntRange(i, a.Int[i])
+					b.Int[i] = o.EnforceIntRange(i, b.Int[i])
+				}
+			}
 		}
 
 		if o.BinInt > 0 && o.ClearFlt {
@@ -300,18 +554,18 @@ func (o *Optimiser) Tournament(A, B, a, b *Solution, m *Metrics) {
 	dBa := B.Distance(a, m.Fmin, m.Fmax, m.Imin, m.Imax)
 	dBb := B.Distance(b, m.Fmin, m.Fmax, m.Imin, m.Imax)
 	if dAa+dBb < dAb+dBa {
-		if !A.Fight(a) {
+		if !A.Fixed && !A.Fight(a) {
 			a.CopyInto(A)
 		}
-		if !B.Fight(b) {
+		if !B.Fixed && !B.Fight(b) {
 			b.CopyInto(B)
 		}
 		return
 	}
-	if !A.Fight(b) {
+	if !A.Fixed && !A.Fight(b) {
 		b.CopyInto(A)
 	}
-	if !B.Fight(a) {
+	if !B.Fixed && !B.Fight(a) {
 		a.CopyInto(B)
 	}
 }
@@ -332,12 +586,20 @@ func (o *Optimiser) generate_solutions(itrial int) {
 		}()
 	}
 
+	// time-varying parameters at t = 0
+	o.ApplySched(0)
+
 	// generate
 	if o.GenAll {
 		o.Generator(o.Solutions, &o.Parameters)
+		o.seed_solutions(o.Solutions, 0)
 		for _, sol := range o.Solutions {
 			o.ObjFunc(sol, 0)
 		}
+		o.Nfeval = o.Nsol
+		if o.GenType == "opposition" {
+			o.Nfeval += o.opposition(o.Solutions, 0)
+		}
 	} else {
 		done := make(chan int, o.Ncpu)
 		for icpu := 0; icpu < o.Ncpu; icpu++ {
@@ -345,43 +607,256 @@ func (o *Optimiser) generate_solutions(itrial int) {
 				start, endp1 := (cpu*o.Nsol)/o.Ncpu, ((cpu+1)*o.Nsol)/o.Ncpu
 				sols := o.Solutions[start:endp1]
 				o.Generator(sols, &o.Parameters)
+				o.seed_solutions(sols, start)
 				for _, sol := range sols {
 					o.ObjFunc(sol, cpu)
 				}
-				done <- 1
+				nfeval := len(sols)
+				if o.GenType == "opposition" {
+					nfeval += o.opposition(sols, cpu)
+				}
+				done <- nfeval
 			}(icpu)
 		}
+		o.Nfeval = 0
 		for cpu := 0; cpu < o.Ncpu; cpu++ {
-			<-done
+			o.Nfeval += <-done
 		}
 	}
 	tgen = gotime.Now()
 
 	// metrics
 	o.iova0 = -1
-	o.Nfeval = o.Nsol
 	o.Metrics.Compute(o.Solutions)
 
 	// meshes
-	if o.Nflt > 1 && o.UseMesh {
-		var err error
-		Xi, Xj := make([]float64, o.Nsol), make([]float64, o.Nsol)
+	if o.UseMesh {
+		o.build_meshes(true)
+	}
+	tmsh = gotime.Now()
+}
+
+// sched_update applies the schedules of time-varying parameters and records their values
+func (o *Optimiser) sched_update(time int) {
+	if len(o.Sched) == 0 {
+		return
+	}
+	if o.SchedVals == nil {
+		o.SchedVals = make(map[string][]float64)
+	}
+	epsh := o.EpsH
+	o.ApplySched(time)
+	if o.EpsH != epsh {
+		o.update_oor_h()
+	}
+	o.SchedTimes = append(o.SchedTimes, time)
+	if o.Verbose {
+		io.Pf("\ntime = %10d:", time)
+	}
+	for _, key := range o.SchedKeys() {
+		v := *o.schedTarget(key)
+		o.SchedVals[key] = append(o.SchedVals[key], v)
+		if o.Verbose {
+			io.Pf(" %s = %g", key, v)
+		}
+	}
+	if o.Verbose {
+		io.Pf("\n")
+	}
+}
+
+// update_oor_h recomputes the out-of-range values of equality constraints of all solutions with
+// the current EpsH; thus, feasibility is not assessed with different tolerances after EpsH changes
+func (o *Optimiser) update_oor_h() {
+	for _, sol := range o.Solutions {
+		for i, h := range sol.Habs {
+			sol.Oor[o.Ng+i] = utl.GtePenalty(o.EpsH, h, 1) // ϵ ≥ |h[i]|
+		}
+	}
+}
+
+// build_meshes (re-)computes the Delaunay triangulations of (xi,xj) points of all solutions
+//  strict -- panic if a triangulation fails; otherwise, the previous mesh is kept
+func (o *Optimiser) build_meshes(strict bool) {
+	if o.Meshes == nil {
 		o.Meshes = make([][]*Mesh, o.Nflt-1)
 		for i := 0; i < o.Nflt-1; i++ {
 			o.Meshes[i] = make([]*Mesh, o.Nflt)
+			for j := i + 1; j < o.Nflt; j++ {
+				o.Meshes[i][j] = new(Mesh)
+			}
+		}
+		o.mshIdx = make(map[*Solution]int)
+		for k, s := range o.Solutions {
+			o.mshIdx[s] = k
+		}
+	}
+	Xi, Xj := make([]float64, o.Nsol), make([]float64, o.Nsol)
+	for i := 0; i < o.Nflt-1; i++ {
+		for k, s := range o.Solutions {
+			Xi[k] = s.Flt[i]
+		}
+		for j := i + 1; j < o.Nflt; j++ {
 			for k, s := range o.Solutions {
-				Xi[k] = s.Flt[i]
+				Xj[k] = s.Flt[j]
 			}
-			for j := i + 1; j < o.Nflt; j++ {
-				for k, s := range o.Solutions {
-					Xj[k] = s.Flt[j]
-				}
-				o.Meshes[i][j].V, o.Meshes[i][j].C, err = tri.Delaunay(Xi, Xj, false)
-				if err != nil {
+			V, C, err := tri.Delaunay(Xi, Xj, false)
+			if err != nil {
+				if strict {
 					chk.Panic("Delaunay2d failed:%v\n", err)
 				}
+				continue
+			}
+			msh := o.Meshes[i][j]
+			msh.V, msh.C = V, C
+			msh.Vc = make([][]int, o.Nsol)
+			for c, cell := range C {
+				for _, k := range cell {
+					if k < o.Nsol { // extra vertices may be added by the triangulator
+						msh.Vc[k] = append(msh.Vc[k], c)
+					}
+				}
 			}
 		}
 	}
-	tmsh = gotime.Now()
+}
+
+// mesh_move moves (xi,xj) of offspring 'a' to a random point within a random triangle attached to
+// parent 'A' of a random (xi,xj) mesh
+//  Note: the move happens with probability MshPm. Only parents in Solutions have a vertex
+func (o *Optimiser) mesh_move(a, A *Solution) {
+	if !rnd.FlipCoin(o.MshPm) {
+		return
+	}
+	k, ok := o.mshIdx[A]
+	if !ok {
+		return
+	}
+	i := rnd.Int(0, o.Nflt-2)
+	j := rnd.Int(i+1, o.Nflt-1)
+	msh := o.Meshes[i][j]
+	if len(msh.Vc) == 0 || len(msh.Vc[k]) == 0 { // duplicated point or failed triangulation
+		return
+	}
+	cell := msh.C[msh.Vc[k][rnd.Int(0, len(msh.Vc[k])-1)]]
+	p0, p1, p2 := msh.V[cell[0]], msh.V[cell[1]], msh.V[cell[2]]
+	r1, r2 := rnd.Float64(0, 1), rnd.Float64(0, 1)
+	if r1+r2 > 1 { // uniform distribution within the triangle
+		r1, r2 = 1-r1, 1-r2
+	}
+	a.Flt[i] = o.EnforceRange(i, p0[0]+r1*(p1[0]-p0[0])+r2*(p2[0]-p0[0]))
+	a.Flt[j] = o.EnforceRange(j, p0[1]+r1*(p1[1]-p0[1])+r2*(p2[1]-p0[1]))
+}
+
+// seed_solutions replaces generated solutions by the seeds in SeedFlt and SeedInt
+//  sols  -- subset of Solutions
+//  start -- index of sols[0] in Solutions
+//  Note: seeds are evenly spread over Solutions (thus over groups) but do not replace the extra
+//        solutions of the mesh method. Seeds in excess are ignored. Seeds are checked by setup
+func (o *Optimiser) seed_solutions(sols []*Solution, start int) {
+	nseed := utl.Imax(len(o.SeedFlt), len(o.SeedInt))
+	navail := o.Nsol - o.NumExtraSols
+	if nseed > navail {
+		nseed = navail
+	}
+	for _, sol := range sols {
+		sol.Seed = false
+	}
+	for k := 0; k < nseed; k++ {
+		i := (k*navail)/nseed - start
+		if i < 0 || i >= len(sols) {
+			continue
+		}
+		sol := sols[i]
+		sol.Seed = true
+		if o.VarLen {
+			o.seed_varlen(sol, k)
+			continue
+		}
+		if len(o.SeedFlt) > k {
+			for j := 0; j < o.Nflt; j++ {
+				sol.Flt[j] = o.EnforceRange(j, o.SeedFlt[k][j])
+			}
+			o.SnapFlt(sol.Flt)
+			if o.FltBits > 0 {
+				o.EncodeFlt(sol.Int, sol.Flt)
+				o.DecodeFlt(sol.Flt, sol.Int)
+			}
+		}
+		if len(o.SeedInt) > k && o.FltBits == 0 {
+			copy(sol.Int, o.SeedInt[k])
+			if o.BinInt == 0 && o.PermInt == 0 {
+				for j := 0; j < o.Nint; j++ {
+					sol.Int[j] = o.EnforceIntRange(j, sol.Int[j])
+				}
+			}
+		}
+	}
+}
+
+// seed_varlen replaces the genes of sol by the k-th seed with variable-length chromosomes
+//  Note: seeds are checked by check_varlen_seeds
+func (o *Optimiser) seed_varlen(sol *Solution, k int) {
+	var ngene int
+	if o.Nflt > 0 {
+		ngene = len(o.SeedFlt[k]) / o.Nflt
+	} else {
+		ngene = len(o.SeedInt[k]) / o.Nint
+	}
+	sol.Flt = sol.Flt[:ngene*o.Nflt]
+	sol.Int = sol.Int[:ngene*o.Nint]
+	for j := range sol.Flt {
+		sol.Flt[j] = o.EnforceRange(j%o.Nflt, o.SeedFlt[k][j])
+	}
+	for g := 0; g < ngene; g++ {
+		o.SnapFlt(sol.Flt[g*o.Nflt:])
+	}
+	for j := range sol.Int {
+		sol.Int[j] = o.EnforceIntRange(j%o.Nint, o.SeedInt[k][j])
+	}
+}
+
+// check_varlen_seeds checks the seeds with variable-length chromosomes: each seed must hold
+// LenMin to LenMax genes, with both floats and ints if there are floats and ints
+func (o *Optimiser) check_varlen_seeds() error {
+	ngenes := func(key string, k, n, m int) (ngene int, err error) {
+		if n%m != 0 || n/m < o.LenMin || n/m > o.LenMax {
+			return 0, chk.Err("seed %d must have %d to %d genes with %d values each. len(%s[%d]) = %d is invalid", k, o.LenMin, o.LenMax, m, key, k, n)
+		}
+		return n / m, nil
+	}
+	nseed := utl.Imax(len(o.SeedFlt), len(o.SeedInt))
+	for k := 0; k < nseed; k++ {
+		if (o.Nflt > 0 && len(o.SeedFlt) <= k) || (o.Nint > 0 && len(o.SeedInt) <= k) {
+			return chk.Err("seed %d with variable-length chromosomes must have both floats and ints", k)
+		}
+		nf, ni := -1, -1
+		var err error
+		if o.Nflt > 0 {
+			if nf, err = ngenes("SeedFlt", k, len(o.SeedFlt[k]), o.Nflt); err != nil {
+				return err
+			}
+		}
+		if o.Nint > 0 {
+			if ni, err = ngenes("SeedInt", k, len(o.SeedInt[k]), o.Nint); err != nil {
+				return err
+			}
+		}
+		if nf >= 0 && ni >= 0 && nf != ni {
+			return chk.Err("floats and ints of seed %d must have the same number of genes. %d != %d", k, nf, ni)
+		}
+	}
+	return nil
+}
+
+// NumSeed returns the number of current solutions that are seeds or copies of seeds
+//  Note: this number decreases as seeds are beaten by offspring; thus it helps to track the
+//        influence of seeds on the evolution
+func (o *Optimiser) NumSeed() (n int) {
+	for _, sol := range o.Solutions {
+		if sol.Seed {
+			n++
+		}
+	}
+	return
 }
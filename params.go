@@ -6,10 +6,13 @@ package goga
 
 import (
 	"encoding/json"
+	"math"
+	"sort"
 
 	"github.com/cpmech/gosl/chk"
 	"github.com/cpmech/gosl/io"
 	"github.com/cpmech/gosl/rnd"
+	"github.com/cpmech/gosl/utl"
 )
 
 // Parameters hold all configuration parameters
@@ -25,30 +28,78 @@ type Parameters struct {
 	Tf    int // final time
 	DtExc int // delta time for exchange
 	DtOut int // delta time for output
+	DtMsh int // delta time for re-triangulation of meshes (only if UseMesh==true)
 
 	// options
 	DEC      float64 // C-coefficient for differential evolution
 	Pll      bool    // parallel
 	Seed     int     // seed for random numbers generator
-	GenType  string  // generation type: "latin", "halton", "rnd"
+	GenType  string  // generation type: "latin", "halton", "rnd", "sobol", "maximin" (maximin Latin Hypercube) or "opposition" (opposition-based)
 	LatinDup int     // Latin Hypercube duplicates number
+	LatinNit int     // number of trial swaps to optimise maximin Latin Hypercubes
 	EpsH     float64 // minimum value for 'h' constraints
 	Verbose  bool    // show messages
 	GenAll   bool    // generate all solutions together; i.e. not within each group/CPU
 	Nsamples int     // run many samples
 	BinInt   int     // flag that integers represent binary numbers if BinInt > 0; thus Nint=BinInt
+	PermInt  int     // flag that integers represent a permutation of {0,1,...,PermInt-1} if PermInt > 0; thus Nint=PermInt
+	PermDist string  // distance between permutations: "adjacency" or "kendall"
+	Perm2opt bool    // improve permutation offspring with 2-opt moves (needs Optimiser.DistMat)
+	PermOrOp bool    // improve permutation offspring with Or-opt moves (needs Optimiser.DistMat)
 	ClearFlt bool    // clear flt if corresponding int is 0
 	ExcTour  bool    // use exchange via tournament
 	ExcOne   bool    // use exchange one randomly
 	NormFlt  bool    // normalise float values
-	UseMesh  bool    // use meshes to control points movement
+	UseMesh  bool    // use meshes to control points movement (requires GenAll)
 	Nbry     int     // number of points along boundary / per iFlt (only if UseMesh==true)
+	MshPm    float64 // probability of moving (xi,xj) of offspring into a triangle attached to the parent (only if UseMesh==true)
+	SeedFile string  // ".res" file (see WriteAllValues) with solutions seeding the initial population; e.g. to warm-start
+	ConvHist string  // record convergence history (see History): "" (none), "exc" (every DtExc) or "out" (every DtOut)
+
+	// boundary handling of floats
+	FltBry    string   // boundary handling of out-of-range floats: "proj", "reflect", "random", "midpoint" or "periodic"
+	FltBryVar []string // [nflt] per-variable boundary handling overriding FltBry; e.g. "periodic" for angles. empty entries => FltBry
 
 	// crossover and mutation of integers
 	IntPc       float64 // probability of crossover for ints
 	IntNcuts    int     // number of cuts in crossover of ints
 	IntPm       float64 // probability of mutation for ints
 	IntNchanges int     // number of changes during mutation of ints
+	IntCreep    int     // maximum step in creep mutation of ints
+	IntDE       bool    // use discrete differential evolution for ints instead of CxInt and MtInt
+	IntPbit     float64 // probability of flipping each bit in MtIntBinBit. default (≤ 0) => 1/Nint
+
+	// binary encoding of floats
+	FltBits int // if > 0, floats are encoded as Gray-coded bit strings with FltBits bits each; thus BinInt=Nflt*FltBits
+
+	// variable-length chromosomes
+	VarLen  bool    // variable-length chromosomes: Flt and Int hold LenMin to LenMax genes, each with len(FltMin) floats and len(IntMin) ints
+	LenMin  int     // minimum number of genes
+	LenMax  int     // maximum number of genes
+	VarPc   float64 // probability of cut-and-splice crossover
+	VarPins float64 // probability of inserting a gene during mutation
+	VarPdel float64 // probability of deleting a gene during mutation
+
+	// local search
+	LsType   string  // local search of elite solutions every DtExc: "" (none), "nm" (Nelder-Mead), "hj" (Hooke-Jeeves) or "cs" (coordinate search)
+	LsNsol   int     // number of elite solutions refined by local search (best ones or from front 0)
+	LsNfeval int     // maximum number of function evaluations per refined solution
+	LsStep   float64 // initial step size of local search relative to FltMax-FltMin
+	LsTol    float64 // minimum step size (or simplex size) of local search relative to FltMax-FltMin
+
+	// gradient-based polishing (needs Optimiser.MinGrad)
+	PolNit     int     // maximum number of outer (augmented Lagrangian) iterations of polishing after Solve. 0 => no polishing
+	PolNitBfgs int     // maximum number of inner (BFGS) iterations of polishing
+	PolMu      float64 // initial penalty coefficient of augmented Lagrangian
+	PolTol     float64 // tolerance for constraint violation, gradient norm and relative change of polishing
+
+	// lexicographic optimisation
+	Lexico  bool      // use lexicographic comparison of objective values instead of Pareto dominance
+	LexPrio []int     // priority order of objectives; e.g. [1,0] => f1 is more important than f0. default = [0,1,...]
+	LexTols []float64 // [nova] tolerances: differences in f[i] smaller than or equal to LexTols[i] are ignored
+
+	// time-varying parameters
+	Sched map[string]*Schedule // schedules of "DEC", "IntPc", "IntPm" or "EpsH" as functions of time/Tf (applied every DtExc)
 
 	// range
 	FltMin []float64 // minimum float allowed
@@ -56,11 +107,20 @@ type Parameters struct {
 	IntMin []int     // minimum int allowed
 	IntMax []int     // maximum int allowed
 
+	// discrete and categorical variables
+	FltSets [][]float64 // [nflt] allowed values of discrete (ordered) floats. nil or empty entries => continuous floats
+	IntCats [][]string  // [nint] labels of categorical (unordered) ints. nil or empty entries => ordinary ints
+
 	// derived
 	Nflt   int       // number of floats
 	Nint   int       // number of integers
 	DelFlt []float64 // max float range
 	DelInt []int     // max int range
+	Ndis   int       // number of discrete floats
+	Ncat   int       // number of categorical ints
+
+	// derived: sorted copy of FltSets (FltSets is not modified; e.g. because of Write)
+	fltSorted [][]float64
 
 	// extra variables not directly related to GOGA (for convenience of having a reader already)
 	Strategy int  // strategy
@@ -87,6 +147,7 @@ func (o *Parameters) Default() {
 	o.Tf = 100
 	o.DtExc = -1
 	o.DtOut = -1
+	o.DtMsh = -1
 
 	// options
 	o.DEC = 0.8
@@ -94,32 +155,75 @@ func (o *Parameters) Default() {
 	o.Seed = 0
 	o.GenType = "latin"
 	o.LatinDup = 2
+	o.LatinNit = 1000
 	o.EpsH = 0.1
 	o.Verbose = true
 	o.GenAll = false
 	o.Nsamples = 10
 	o.BinInt = 0
+	o.PermInt = 0
+	o.PermDist = "adjacency"
+	o.Perm2opt = false
+	o.PermOrOp = false
 	o.ClearFlt = false
 	o.ExcTour = true
 	o.ExcOne = true
 	o.NormFlt = false
 	o.UseMesh = false
 	o.Nbry = 3
+	o.MshPm = 0.5
+	o.SeedFile = ""
+	o.ConvHist = ""
+
+	// boundary handling of floats
+	o.FltBry = "proj"
 
 	// crossover and mutation of integers
 	o.IntPc = 0.8
 	o.IntNcuts = 1
 	o.IntPm = 0.01
 	o.IntNchanges = 1
+	o.IntCreep = 1
+	o.IntDE = false
+	o.IntPbit = 0
+
+	// binary encoding of floats
+	o.FltBits = 0
+
+	// variable-length chromosomes
+	o.VarLen = false
+	o.LenMin = 1
+	o.LenMax = 10
+	o.VarPc = 0.8
+	o.VarPins = 0.1
+	o.VarPdel = 0.1
+
+	// local search
+	o.LsType = ""
+	o.LsNsol = 1
+	o.LsNfeval = 100
+	o.LsStep = 0.1
+	o.LsTol = 1e-6
+
+	// gradient-based polishing
+	o.PolNit = 20
+	o.PolNitBfgs = 100
+	o.PolMu = 10
+	o.PolTol = 1e-8
 }
 
 // Read reads configuration parameters from JSON file
+//  Note: YAML (".yaml" or ".yml") and TOML (".toml") files are also accepted
 func (o *Parameters) Read(filenamepath string) {
 	o.Default()
 	b, err := io.ReadFile(filenamepath)
 	if err != nil {
 		chk.Panic("cannot read parameters file %q", filenamepath)
 	}
+	b, err = toJSON(b, filenamepath)
+	if err != nil {
+		chk.Panic("cannot read parameters file %q:\n%v", filenamepath, err)
+	}
 	err = json.Unmarshal(b, o)
 	if err != nil {
 		chk.Panic("cannot unmarshal parameters file %q", filenamepath)
@@ -128,23 +232,18 @@ func (o *Parameters) Read(filenamepath string) {
 }
 
 // CalcDerived computes derived variables and checks consistency
+//  Note: the consistency is checked by Validate; CalcDerived panics with all problems found
 func (o *Parameters) CalcDerived() {
 
 	// check
-	if o.Nova < 1 {
-		chk.Panic("number of objective values (nova) must be greater than 0")
-	}
-	if o.Nsol < 6 {
-		chk.Panic("number of solutions must greater than 6. Nsol = %d is invalid", o.Nsol)
+	if err := o.Validate(); err != nil {
+		chk.Panic("%v", err)
 	}
 	if o.Ncpu < 2 {
 		o.Ncpu = 1
 		o.Pll = false
 		o.DtExc = 1
 	}
-	if o.Ncpu > o.Nsol/2 {
-		chk.Panic("number of CPU must be smaller than or equal to half the number of solutions. Ncpu=%d > Nsol/2=%d", o.Ncpu, o.Nsol/2)
-	}
 	if o.Tf < 1 {
 		o.Tf = 1
 	}
@@ -154,20 +253,74 @@ func (o *Parameters) CalcDerived() {
 	if o.DtOut < 1 {
 		o.DtOut = o.Tf / 5
 	}
+	if o.DtMsh < 1 {
+		o.DtMsh = utl.Imax(1, o.Tf/10)
+	}
 
 	// derived
+	if len(o.FltMin) == 0 && len(o.FltSets) > 0 {
+		o.FltMin = make([]float64, len(o.FltSets))
+		o.FltMax = make([]float64, len(o.FltSets))
+	}
+	if len(o.IntMin) == 0 && len(o.IntCats) > 0 {
+		o.IntMin = make([]int, len(o.IntCats))
+		o.IntMax = make([]int, len(o.IntCats))
+	}
 	o.Nflt = len(o.FltMin)
 	o.Nint = len(o.IntMin)
+	if o.FltBits > 0 {
+		o.BinInt = o.Nflt * o.FltBits
+		o.ClearFlt = false
+	}
 	if o.BinInt > 0 {
 		o.Nint = o.BinInt
 	}
-	if o.Nflt == 0 && o.Nint == 0 {
-		chk.Panic("either floats and ints must be set (via FltMin/Max or IntMin/Max)")
+	if o.PermInt > 0 {
+		o.Nint = o.PermInt
+		if len(o.IntMin) == 0 {
+			o.IntMin = make([]int, o.Nint)
+			o.IntMax = make([]int, o.Nint)
+			for i := 0; i < o.Nint; i++ {
+				o.IntMax[i] = o.Nint - 1
+			}
+		}
+	}
+	// discrete floats
+	o.Ndis = 0
+	o.fltSorted = nil
+	if len(o.FltSets) > 0 {
+		o.fltSorted = make([][]float64, len(o.FltSets))
+		for i, set := range o.FltSets {
+			if len(set) == 0 {
+				continue
+			}
+			sorted := append([]float64(nil), set...)
+			sort.Float64s(sorted)
+			o.fltSorted[i] = sorted
+			o.FltMin[i], o.FltMax[i] = sorted[0], sorted[len(sorted)-1]
+			o.Ndis++
+		}
+	}
+
+	// categorical ints
+	o.Ncat = 0
+	if len(o.IntCats) > 0 {
+		for i, labels := range o.IntCats {
+			if len(labels) == 0 {
+				continue
+			}
+			o.IntMin[i], o.IntMax[i] = 0, len(labels)-1
+			o.Ncat++
+		}
+	}
+
+	// variable-length chromosomes
+	if o.VarLen {
+		o.ClearFlt = false
 	}
 
 	// floats
 	if o.Nflt > 0 {
-		chk.IntAssert(len(o.FltMax), o.Nflt)
 		o.DelFlt = make([]float64, o.Nflt)
 		for i := 0; i < o.Nflt; i++ {
 			o.DelFlt[i] = o.FltMax[i] - o.FltMin[i]
@@ -185,13 +338,14 @@ func (o *Parameters) CalcDerived() {
 		o.NumXiXjPairs = (o.Nflt*o.Nflt - o.Nflt) / 2
 		o.NumXiXjBryPts = (o.Nbry-2)*4 + 4
 		o.NumExtraSols = o.NumXiXjPairs * o.NumXiXjBryPts
-		io.PfYel("NumXiXjPairs=%d NumXiXjBryPts=%d NumExtraSols=%d\n", o.NumXiXjPairs, o.NumXiXjBryPts, o.NumExtraSols)
+		if o.Verbose {
+			io.PfYel("NumXiXjPairs=%d NumXiXjBryPts=%d NumExtraSols=%d\n", o.NumXiXjPairs, o.NumXiXjBryPts, o.NumExtraSols)
+		}
 		o.Nsol += o.NumExtraSols
 	}
 
 	// generic ints
 	if o.BinInt == 0 && o.Nint > 0 {
-		chk.IntAssert(len(o.IntMax), o.Nint)
 		o.DelInt = make([]int, o.Nint)
 		for i := 0; i < o.Nint; i++ {
 			o.DelInt[i] = o.IntMax[i] - o.IntMin[i]
@@ -209,12 +363,96 @@ func (o *Parameters) CalcDerived() {
 		if o.IntNchanges > o.Nint {
 			o.IntNchanges = o.Nint
 		}
+		if o.IntCreep < 1 {
+			o.IntCreep = 1
+		}
+		if o.IntPbit <= 0 {
+			o.IntPbit = 1.0 / float64(o.Nint)
+		}
+	}
+
+	// local search
+	if o.LsType != "" && o.LsNsol < 1 {
+		o.LsNsol = 1
+	}
+
+	// lexicographic optimisation
+	if o.Lexico {
+		if len(o.LexPrio) == 0 {
+			o.LexPrio = utl.IntRange(o.Nova)
+		}
+		if len(o.LexTols) == 0 {
+			o.LexTols = make([]float64, o.Nova)
+		}
 	}
 
 	// initialise random numbers generator
 	rnd.Init(o.Seed)
 }
 
+// LexKeys returns the sorting keys corresponding to the lexicographic order of objectives
+//  Note: solutions are ranked by constraint violation first
+func (o *Parameters) LexKeys() (keys []SortKey) {
+	keys = []SortKey{{Type: KeyOor}}
+	for _, idx := range o.LexPrio {
+		keys = append(keys, SortKey{Type: KeyOva, Idx: idx, Tol: o.LexTols[idx]})
+	}
+	return
+}
+
+// LexCompare compares objective values lexicographically with tolerances LexTols
+//  Output: A_wins or B_wins or neither (tie)
+//  Note: ties within tolerances are not transitive; thus, to compare many points consistently,
+//        their buckets should be compared instead. see LexBuckets and LexBest
+func (o *Parameters) LexCompare(A, B []float64) (A_wins, B_wins bool) {
+	return o.lexCompare(A, B, o.LexTols)
+}
+
+// LexBuckets returns the lexicographic buckets of objective values F [npoints][nova]; i.e. the
+// values of each objective are replaced by the indices of buckets with width LexTols, as in
+// SortSolutions. Buckets are compared without tolerances; thus the comparison is transitive
+func (o *Parameters) LexBuckets(F [][]float64) (B [][]float64) {
+	B = make([][]float64, len(F))
+	for i, f := range F {
+		B[i] = append([]float64(nil), f...)
+	}
+	for _, idx := range o.LexPrio {
+		if o.LexTols[idx] > 0 {
+			bucketize(B, idx, o.LexTols[idx])
+		}
+	}
+	return
+}
+
+// LexBest returns the index of the lexicographically best point of F [npoints][nova]
+//  Note: the buckets of F are compared; see LexBuckets. The first point is returned in ties
+func (o *Parameters) LexBest(F [][]float64) (best int) {
+	B := o.LexBuckets(F)
+	for i := 1; i < len(B); i++ {
+		if better, _ := o.lexCompare(B[i], B[best], nil); better {
+			best = i
+		}
+	}
+	return
+}
+
+// lexCompare compares A and B lexicographically. tols may be nil (no tolerances)
+func (o *Parameters) lexCompare(A, B, tols []float64) (A_wins, B_wins bool) {
+	for _, idx := range o.LexPrio {
+		if tols != nil && math.Abs(A[idx]-B[idx]) <= tols[idx] {
+			continue
+		}
+		if A[idx] == B[idx] {
+			continue
+		}
+		if A[idx] < B[idx] {
+			return true, false
+		}
+		return false, true
+	}
+	return
+}
+
 // EnforceRange makes sure x is within given range
 func (o *Parameters) EnforceRange(i int, x float64) float64 {
 	if x < o.FltMin[i] {
@@ -226,6 +464,125 @@ func (o *Parameters) EnforceRange(i int, x float64) float64 {
 	return x
 }
 
+// FltBryKind returns the boundary handling of float i; i.e. FltBryVar[i] or FltBry
+func (o *Parameters) FltBryKind(i int) string {
+	if len(o.FltBryVar) > 0 && o.FltBryVar[i] != "" {
+		return o.FltBryVar[i]
+	}
+	return o.FltBry
+}
+
+// FltBoundary brings x back into [lo,hi] according to the boundary handling of float i
+//  xp -- value of the parent; i.e. the value before variation (used by "midpoint")
+//  Note: lo and hi are FltMin[i] and FltMax[i] or 0 and 1 if floats are normalised
+//  Options:
+//   "proj"     -- projection onto the nearest bound (clipping)
+//   "reflect"  -- reflection on the violated bound
+//   "random"   -- random reinitialisation within [lo,hi]
+//   "midpoint" -- midpoint between the violated bound and the parent
+//   "periodic" -- periodic wrapping; e.g. for angles
+func (o *Parameters) FltBoundary(i int, x, xp, lo, hi float64) float64 {
+	if x >= lo && x <= hi {
+		return x
+	}
+	w := hi - lo
+	switch o.FltBryKind(i) {
+	case "reflect":
+		y := math.Mod(x-lo, 2.0*w)
+		if y < 0 {
+			y += 2.0 * w
+		}
+		if y > w {
+			y = 2.0*w - y
+		}
+		x = lo + y
+	case "random":
+		x = rnd.Float64(lo, hi)
+	case "midpoint":
+		if x < lo {
+			x = (lo + xp) / 2.0
+		} else {
+			x = (hi + xp) / 2.0
+		}
+	case "periodic":
+		y := math.Mod(x-lo, w)
+		if y < 0 {
+			y += w
+		}
+		x = lo + y
+	}
+	return utl.Max(lo, utl.Min(hi, x)) // also handles "proj", w = 0 and parents out of range
+}
+
+// IsDis tells whether float i is discrete; i.e. it can only assume values in FltSets[i]
+func (o *Parameters) IsDis(i int) bool {
+	return o.Ndis > 0 && len(o.FltSets[i]) > 0
+}
+
+// IsCat tells whether int i is categorical; i.e. it is the index of a label in IntCats[i]
+func (o *Parameters) IsCat(i int) bool {
+	return o.Ncat > 0 && len(o.IntCats[i]) > 0
+}
+
+// SnapFlt replaces the values of discrete floats in x by the nearest allowed values
+func (o *Parameters) SnapFlt(x []float64) {
+	if o.Ndis == 0 {
+		return
+	}
+	for i := 0; i < o.Nflt; i++ {
+		if !o.IsDis(i) {
+			continue
+		}
+		set := o.fltSorted[i]
+		k := sort.SearchFloat64s(set, x[i])
+		if k == len(set) {
+			k--
+		} else if k > 0 && x[i]-set[k-1] < set[k]-x[i] {
+			k--
+		}
+		x[i] = set[k]
+	}
+}
+
+// IntLabel returns the label of int i with value v if categorical or the formatted value otherwise
+func (o *Parameters) IntLabel(i, v int) string {
+	if o.IsCat(i) && v >= 0 && v < len(o.IntCats[i]) {
+		return o.IntCats[i][v]
+	}
+	return io.Sf("%d", v)
+}
+
+// EnforceIntRange makes sure x is within given range
+func (o *Parameters) EnforceIntRange(i int, x int) int {
+	if x < o.IntMin[i] {
+		return o.IntMin[i]
+	}
+	if x > o.IntMax[i] {
+		return o.IntMax[i]
+	}
+	return x
+}
+
+// DecodeFlt decodes Gray-coded bits into floats x ∈ [xmin,xmax]
+//  bits -- [Nflt*FltBits] Gray-coded bit string (most significant bit first)
+func (o *Parameters) DecodeFlt(x []float64, bits []int) {
+	kmax := float64(uint64(1)<<uint(o.FltBits) - 1)
+	for i := 0; i < o.Nflt; i++ {
+		k := GrayDecode(bits[i*o.FltBits : (i+1)*o.FltBits])
+		x[i] = o.FltMin[i] + float64(k)*o.DelFlt[i]/kmax
+	}
+}
+
+// EncodeFlt encodes floats x ∈ [xmin,xmax] into Gray-coded bits with the nearest representable values
+//  bits -- [Nflt*FltBits] Gray-coded bit string (most significant bit first)
+func (o *Parameters) EncodeFlt(bits []int, x []float64) {
+	kmax := float64(uint64(1)<<uint(o.FltBits) - 1)
+	for i := 0; i < o.Nflt; i++ {
+		r := (o.EnforceRange(i, x[i]) - o.FltMin[i]) / o.DelFlt[i]
+		GrayEncode(bits[i*o.FltBits:(i+1)*o.FltBits], uint64(math.Floor(r*kmax+0.5)))
+	}
+}
+
 // Normalise4 normalises x ∈ [xmin,xmax] values into r ∈ [0,1]
 func (o *Parameters) Normalise4(x, x0, x1, x2 []float64) (r, r0, r1, r2 []float64) {
 	if o.Nflt < 1 {
@@ -258,72 +615,141 @@ func (o *Parameters) DeNormalise1(r []float64) {
 	}
 }
 
-// LogParams returns a log with current parameters
-func (o *Parameters) LogParams() (l string) {
-
-	// sizes
-	l += io.ArgsTable("SIZES",
-		"number of objective values", "Nova", o.Nova,
-		"number of out-of-range values", "Noor", o.Noor,
-		"total number of solutions", "Nsol", o.Nsol,
-		"number of cpus", "Ncpu", o.Ncpu,
-	)
-
-	// time
-	l += "\n"
-	l += io.ArgsTable("TIME",
-		"final time", "Tf", o.Tf,
-		"delta time for exchange", "DtExc", o.DtExc,
-		"delta time for output", "DtOut", o.DtOut,
-	)
+// fltBryValid checks the name of a boundary handling method
+func fltBryValid(kind string) bool {
+	switch kind {
+	case "proj", "reflect", "random", "midpoint", "periodic":
+		return true
+	}
+	return false
+}
 
-	// options
-	l += "\n"
-	l += io.ArgsTable("OPTIONS",
-		"C-coefficient for differential evolution", "DEC", o.DEC,
-		"parallel", "Pll", o.Pll,
-		"seed for random numbers generator", "Seed", o.Seed,
-		"generation type: 'latin', 'halton', 'rnd'", "GenType", o.GenType,
-		"Latin Hypercube duplicates number", "LatinDup", o.LatinDup,
-		"minimum value for 'h' constraints", "EpsH", o.EpsH,
-		"show messages", "Verbose", o.Verbose,
-		"generate all solutions together", "GenAll", o.GenAll,
-		"run many trials", "Nsamples", o.Nsamples,
-		"integers represent binary numbers", "BinInt", o.BinInt,
-		"clear flt if corresponding int is 0", "ClearFlt", o.ClearFlt,
-		"use exchange via tournament", "ExcTour", o.ExcTour,
-		"use exchange one randomly", "ExcOne", o.ExcOne,
-		"normalise float values", "NormFlt", o.NormFlt,
-		"use meshes to control points movement", "UseMesh", o.UseMesh,
-		"number of points along boundary / per iFlt (only if UseMesh==true)", "Nbry", o.Nbry,
-	)
+// prmsTable holds a table of parameters with (description, key, value) triplets
+type prmsTable struct {
+	title string        // title of table
+	args  []interface{} // description, key, value, description, key, value, ...
+}
 
-	// crossover and mutation of integers
-	l += "\n"
-	l += io.ArgsTable("CROSSOVER AND MUTATION OF INTS",
-		"probability of crossover for ints", "IntPc", o.IntPc,
-		"number of cuts in crossover of ints", "IntNcuts", o.IntNcuts,
-		"probability of mutation for ints", "IntPm", o.IntPm,
-		"number of changes during mutation of ints", "IntNchanges", o.IntNchanges,
-	)
+// LogParams returns a log with current parameters
+func (o *Parameters) LogParams() (l string) {
+	for i, t := range o.logTables() {
+		if i > 0 {
+			l += "\n"
+		}
+		l += io.ArgsTable(t.title, t.args...)
+	}
+	return
+}
 
-	// derived
-	l += "\n"
-	l += io.ArgsTable("DERIVED",
-		"number of floats", "Nflt", o.Nflt,
-		"number of integers", "Nint", o.Nint,
-		"number of (Xi,Xj) pairs", "NumXiXjPairs", o.NumXiXjPairs,
-		"number of points along the boundaries of one (Xi,Xj) plane", "NumXiXjBryPts", o.NumXiXjBryPts,
-		"total number of extra solutions due to all (Xi,Xj) boundaries", "NumExtraSols", o.NumExtraSols,
-	)
+// logTables returns the tables of parameters printed by LogParams
+//  Note: the descriptions are also used by Schema
+func (o *Parameters) logTables() (tables []prmsTable) {
+	tables = []prmsTable{
+		{"SIZES", []interface{}{
+			"number of objective values", "Nova", o.Nova,
+			"number of out-of-range values", "Noor", o.Noor,
+			"total number of solutions", "Nsol", o.Nsol,
+			"number of cpus", "Ncpu", o.Ncpu,
+		}},
+		{"TIME", []interface{}{
+			"final time", "Tf", o.Tf,
+			"delta time for exchange", "DtExc", o.DtExc,
+			"delta time for output", "DtOut", o.DtOut,
+			"delta time for re-triangulation of meshes", "DtMsh", o.DtMsh,
+		}},
+		{"OPTIONS", []interface{}{
+			"C-coefficient for differential evolution", "DEC", o.DEC,
+			"parallel", "Pll", o.Pll,
+			"seed for random numbers generator", "Seed", o.Seed,
+			"generation type: 'latin', 'halton', 'rnd', 'sobol', 'maximin', 'opposition'", "GenType", o.GenType,
+			"Latin Hypercube duplicates number", "LatinDup", o.LatinDup,
+			"number of swaps for maximin Latin Hypercube", "LatinNit", o.LatinNit,
+			"minimum value for 'h' constraints", "EpsH", o.EpsH,
+			"show messages", "Verbose", o.Verbose,
+			"generate all solutions together", "GenAll", o.GenAll,
+			"run many trials", "Nsamples", o.Nsamples,
+			"integers represent binary numbers", "BinInt", o.BinInt,
+			"integers represent a permutation", "PermInt", o.PermInt,
+			"distance between permutations: 'adjacency', 'kendall'", "PermDist", o.PermDist,
+			"improve permutation offspring with 2-opt moves", "Perm2opt", o.Perm2opt,
+			"improve permutation offspring with Or-opt moves", "PermOrOp", o.PermOrOp,
+			"clear flt if corresponding int is 0", "ClearFlt", o.ClearFlt,
+			"use exchange via tournament", "ExcTour", o.ExcTour,
+			"use exchange one randomly", "ExcOne", o.ExcOne,
+			"normalise float values", "NormFlt", o.NormFlt,
+			"use meshes to control points movement (requires GenAll)", "UseMesh", o.UseMesh,
+			"number of points along boundary / per iFlt (only if UseMesh==true)", "Nbry", o.Nbry,
+			"probability of mesh-guided movement of offspring", "MshPm", o.MshPm,
+			"file with solutions seeding the initial population", "SeedFile", o.SeedFile,
+			"record convergence history: '', 'exc', 'out'", "ConvHist", o.ConvHist,
+		}},
+		{"BOUNDARY HANDLING OF FLOATS", []interface{}{
+			"boundary handling: 'proj', 'reflect', 'random', 'midpoint', 'periodic'", "FltBry", o.FltBry,
+			"per-variable boundary handling", "FltBryVar", o.FltBryVar,
+		}},
+		{"CROSSOVER AND MUTATION OF INTS", []interface{}{
+			"probability of crossover for ints", "IntPc", o.IntPc,
+			"number of cuts in crossover of ints", "IntNcuts", o.IntNcuts,
+			"probability of mutation for ints", "IntPm", o.IntPm,
+			"number of changes during mutation of ints", "IntNchanges", o.IntNchanges,
+			"maximum step in creep mutation of ints", "IntCreep", o.IntCreep,
+			"use discrete differential evolution for ints", "IntDE", o.IntDE,
+			"probability of flipping each bit", "IntPbit", o.IntPbit,
+		}},
+		{"BINARY ENCODING OF FLOATS", []interface{}{
+			"number of bits per Gray-coded float", "FltBits", o.FltBits,
+		}},
+		{"VARIABLE-LENGTH CHROMOSOMES", []interface{}{
+			"use variable-length chromosomes", "VarLen", o.VarLen,
+			"minimum number of genes", "LenMin", o.LenMin,
+			"maximum number of genes", "LenMax", o.LenMax,
+			"probability of cut-and-splice crossover", "VarPc", o.VarPc,
+			"probability of inserting a gene", "VarPins", o.VarPins,
+			"probability of deleting a gene", "VarPdel", o.VarPdel,
+		}},
+		{"LOCAL SEARCH", []interface{}{
+			"local search method: '', 'nm', 'hj', 'cs'", "LsType", o.LsType,
+			"number of elite solutions refined by local search", "LsNsol", o.LsNsol,
+			"maximum number of function evaluations per refined solution", "LsNfeval", o.LsNfeval,
+			"initial step size of local search", "LsStep", o.LsStep,
+			"minimum step size of local search", "LsTol", o.LsTol,
+		}},
+		{"GRADIENT-BASED POLISHING", []interface{}{
+			"maximum number of outer iterations of polishing", "PolNit", o.PolNit,
+			"maximum number of inner (BFGS) iterations of polishing", "PolNitBfgs", o.PolNitBfgs,
+			"initial penalty coefficient of augmented Lagrangian", "PolMu", o.PolMu,
+			"tolerance of polishing", "PolTol", o.PolTol,
+		}},
+		{"LEXICOGRAPHIC OPTIMISATION", []interface{}{
+			"use lexicographic comparison of objective values", "Lexico", o.Lexico,
+			"priority order of objectives", "LexPrio", o.LexPrio,
+			"tolerances for each objective", "LexTols", o.LexTols,
+		}},
+	}
 
-	// extra
-	l += "\n"
-	l += io.ArgsTable("EXTRA",
-		"strategy", "Strategy", o.Strategy,
-		"plot set of graphs 1", "PlotSet1", o.PlotSet1,
-		"plot set of graphs 2", "PlotSet2", o.PlotSet2,
-		"problem number", "ProbNum", o.ProbNum,
+	// time-varying parameters
+	if len(o.Sched) > 0 {
+		var args []interface{}
+		for _, key := range o.SchedKeys() {
+			args = append(args, "schedule of "+key, key, o.Sched[key].String())
+		}
+		tables = append(tables, prmsTable{"TIME-VARYING PARAMETERS", args})
+	}
+	return append(tables,
+		prmsTable{"DERIVED", []interface{}{
+			"number of floats", "Nflt", o.Nflt,
+			"number of integers", "Nint", o.Nint,
+			"number of discrete floats", "Ndis", o.Ndis,
+			"number of categorical ints", "Ncat", o.Ncat,
+			"number of (Xi,Xj) pairs", "NumXiXjPairs", o.NumXiXjPairs,
+			"number of points along the boundaries of one (Xi,Xj) plane", "NumXiXjBryPts", o.NumXiXjBryPts,
+			"total number of extra solutions due to all (Xi,Xj) boundaries", "NumExtraSols", o.NumExtraSols,
+		}},
+		prmsTable{"EXTRA", []interface{}{
+			"strategy", "Strategy", o.Strategy,
+			"plot set of graphs 1", "PlotSet1", o.PlotSet1,
+			"plot set of graphs 2", "PlotSet2", o.PlotSet2,
+			"problem number", "ProbNum", o.ProbNum,
+		}},
 	)
-	return
 }
@@ -0,0 +1,321 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"gopkg.in/yaml.v2"
+)
+
+// prmsDerived holds the keys of derived parameters; i.e. not written by Write
+var prmsDerived = map[string]bool{
+	"Nflt": true, "Nint": true, "DelFlt": true, "DelInt": true, "Ndis": true, "Ncat": true,
+	"NumXiXjPairs": true, "NumXiXjBryPts": true, "NumExtraSols": true,
+}
+
+// prmsDesc holds descriptions of parameters that are not printed by LogParams
+var prmsDesc = map[string]string{
+	"FltMin":  "minimum float allowed",
+	"FltMax":  "maximum float allowed",
+	"IntMin":  "minimum int allowed",
+	"IntMax":  "maximum int allowed",
+	"FltSets": "allowed values of discrete (ordered) floats",
+	"IntCats": "labels of categorical (unordered) ints",
+	"Sched":   "schedules of time-varying parameters: 'DEC', 'IntPc', 'IntPm', 'EpsH'",
+}
+
+// prmsRange holds the valid range of a parameter
+type prmsRange struct {
+	min  interface{} // minimum value (inclusive). nil => unbounded
+	max  interface{} // maximum value (inclusive). nil => unbounded
+	enum []string    // valid options of strings
+}
+
+// bounds returns the minimum and maximum values as floats. ±Inf means unbounded
+func (o prmsRange) bounds() (lo, hi float64) {
+	toFloat := func(v interface{}, inf float64) float64 {
+		switch x := v.(type) {
+		case int:
+			return float64(x)
+		case float64:
+			return x
+		}
+		return inf
+	}
+	return toFloat(o.min, math.Inf(-1)), toFloat(o.max, math.Inf(1))
+}
+
+// prmsRanges holds the valid ranges of parameters
+var prmsRanges = map[string]prmsRange{
+	"Nova":        {min: 1},
+	"Noor":        {min: 0},
+	"Nsol":        {min: 6},
+	"Ncpu":        {min: 1},
+	"Tf":          {min: 1},
+	"DEC":         {min: 0, max: 1},
+	"GenType":     {enum: []string{"latin", "halton", "rnd", "sobol", "maximin", "opposition"}},
+	"LatinDup":    {min: 1},
+	"LatinNit":    {min: 0},
+	"EpsH":        {min: 0},
+	"Nsamples":    {min: 1},
+	"BinInt":      {min: 0},
+	"PermInt":     {min: 0},
+	"PermDist":    {enum: []string{"adjacency", "kendall"}},
+	"ConvHist":    {enum: []string{"", "exc", "out"}},
+	"Nbry":        {min: 2},
+	"MshPm":       {min: 0, max: 1},
+	"FltBry":      {enum: []string{"proj", "reflect", "random", "midpoint", "periodic"}},
+	"IntPc":       {min: 0, max: 1},
+	"IntNcuts":    {min: 1},
+	"IntPm":       {min: 0, max: 1},
+	"IntNchanges": {min: 1},
+	"IntPbit":     {max: 1},
+	"FltBits":     {min: 0, max: 52},
+	"LenMin":      {min: 1},
+	"LenMax":      {min: 1},
+	"VarPc":       {min: 0, max: 1},
+	"VarPins":     {min: 0, max: 1},
+	"VarPdel":     {min: 0, max: 1},
+	"LsType":      {enum: []string{"", "nm", "hj", "cs"}},
+	"LsNsol":      {min: 1},
+	"LsNfeval":    {min: 1},
+	"LsStep":      {min: 0},
+	"LsTol":       {min: 0},
+	"PolNit":      {min: 0},
+	"PolNitBfgs":  {min: 1},
+	"PolMu":       {min: 0},
+	"PolTol":      {min: 0},
+}
+
+// Write writes all parameters (including defaults) to a JSON file that can be read by Read
+//  Note: derived parameters are not written. Nsol is written without the extra solutions of the
+//        mesh method; thus Write can be called before or after CalcDerived
+func (o *Parameters) Write(filenamepath string) (err error) {
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	v, t := reflect.ValueOf(*o), reflect.TypeOf(*o)
+	first := true
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || prmsDerived[f.Name] {
+			continue
+		}
+		val := v.Field(i).Interface()
+		if f.Name == "Nsol" {
+			val = o.Nsol - o.NumExtraSols
+		}
+		b, e := json.Marshal(val)
+		if e != nil {
+			return chk.Err("cannot marshal parameter %q:\n%v", f.Name, e)
+		}
+		if !first {
+			buf.WriteString(",\n")
+		}
+		buf.WriteString("  \"" + f.Name + "\" : " + string(b))
+		first = false
+	}
+	buf.WriteString("\n}\n")
+	return writeFile(filenamepath, buf.Bytes())
+}
+
+// Schema returns the JSON Schema of the parameters file
+//  Note: descriptions are taken from LogParams; default values are the ones set by Default.
+//        Property names are case-sensitive in the schema, whereas Read accepts any case
+func (o *Parameters) Schema() (b []byte, err error) {
+
+	// descriptions and defaults
+	var d Parameters
+	d.Default()
+	desc := make(map[string]string)
+	for key, txt := range prmsDesc {
+		desc[key] = txt
+	}
+	for _, table := range d.logTables() {
+		for k := 0; k+2 < len(table.args); k += 3 {
+			desc[table.args[k+1].(string)] = table.args[k].(string)
+		}
+	}
+
+	// properties
+	props := make(map[string]interface{})
+	v, t := reflect.ValueOf(d), reflect.TypeOf(d)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || prmsDerived[f.Name] {
+			continue
+		}
+		prop := jsonSchemaType(f.Type)
+		if txt, ok := desc[f.Name]; ok {
+			prop["description"] = txt
+		}
+		if f.Type.Kind() != reflect.Slice && f.Type.Kind() != reflect.Map {
+			prop["default"] = v.Field(i).Interface()
+		}
+		if rng, ok := prmsRanges[f.Name]; ok {
+			if rng.min != nil {
+				prop["minimum"] = rng.min
+			}
+			if rng.max != nil {
+				prop["maximum"] = rng.max
+			}
+			if len(rng.enum) > 0 {
+				prop["enum"] = rng.enum
+			}
+		}
+		props[f.Name] = prop
+	}
+
+	// schedules
+	sched := props["Sched"].(map[string]interface{})
+	sched["propertyNames"] = map[string]interface{}{"enum": []string{"DEC", "IntPc", "IntPm", "EpsH"}}
+	item := sched["additionalProperties"].(map[string]interface{})
+	item["required"] = []string{"Type"}
+	item["properties"].(map[string]interface{})["Type"].(map[string]interface{})["enum"] = []string{"linear", "exp", "step", "cos"}
+
+	// schema
+	schema := map[string]interface{}{
+		"$schema":              "http://json-schema.org/draft-07/schema#",
+		"title":                "goga parameters",
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+	b, err = json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, chk.Err("cannot marshal schema:\n%v", err)
+	}
+	return
+}
+
+// WriteSchema writes the JSON Schema of the parameters file
+func (o *Parameters) WriteSchema(filenamepath string) (err error) {
+	b, err := o.Schema()
+	if err != nil {
+		return
+	}
+	return writeFile(filenamepath, append(b, '\n'))
+}
+
+// ReadData reads a JSON, YAML (".yaml" or ".yml") or TOML (".toml") file and returns JSON data
+//  Note: this is used to read files that embed parameters; e.g. problem files of cmd/goga
+func ReadData(filenamepath string) (b []byte, err error) {
+	b, err = io.ReadFile(filenamepath)
+	if err != nil {
+		return nil, chk.Err("cannot read file %q:\n%v", filenamepath, err)
+	}
+	b, err = toJSON(b, filenamepath)
+	if err != nil {
+		return nil, chk.Err("cannot read file %q:\n%v", filenamepath, err)
+	}
+	return
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// writeFile writes b to file, creating its directory if needed
+func writeFile(filenamepath string, b []byte) (err error) {
+	err = os.MkdirAll(filepath.Dir(filenamepath), 0777)
+	if err == nil {
+		err = ioutil.WriteFile(filenamepath, b, 0644)
+	}
+	if err != nil {
+		return chk.Err("cannot write file %q:\n%v", filenamepath, err)
+	}
+	return
+}
+
+// jsonSchemaType returns the JSON Schema of a Go type
+func jsonSchemaType(t reflect.Type) (res map[string]interface{}) {
+	res = make(map[string]interface{})
+	switch t.Kind() {
+	case reflect.Bool:
+		res["type"] = "boolean"
+	case reflect.Int, reflect.Int64:
+		res["type"] = "integer"
+	case reflect.Float64:
+		res["type"] = "number"
+	case reflect.String:
+		res["type"] = "string"
+	case reflect.Slice:
+		res["type"] = []string{"array", "null"}
+		res["items"] = jsonSchemaType(t.Elem())
+	case reflect.Map:
+		res["type"] = []string{"object", "null"}
+		res["additionalProperties"] = jsonSchemaType(t.Elem())
+	case reflect.Ptr:
+		return jsonSchemaType(t.Elem())
+	case reflect.Struct:
+		res["type"] = "object"
+		props := make(map[string]interface{})
+		for _, name := range jsonKeys(t) {
+			f, _ := t.FieldByName(name)
+			props[name] = jsonSchemaType(f.Type)
+		}
+		res["properties"] = props
+		res["additionalProperties"] = false
+	}
+	return
+}
+
+// toJSON converts YAML (".yaml" or ".yml") or TOML (".toml") data to JSON
+//  Note: data of other files (e.g. ".json") are returned unchanged
+func toJSON(b []byte, filenamepath string) ([]byte, error) {
+	var data interface{}
+	switch strings.ToLower(filepath.Ext(filenamepath)) {
+	case ".yaml", ".yml":
+		err := yaml.Unmarshal(b, &data)
+		if err != nil {
+			return nil, chk.Err("cannot parse YAML:\n%v", err)
+		}
+		data = yamlToJSON(data)
+	case ".toml":
+		var m map[string]interface{}
+		err := toml.Unmarshal(b, &m)
+		if err != nil {
+			return nil, chk.Err("cannot parse TOML:\n%v", err)
+		}
+		data = m
+	default:
+		return b, nil
+	}
+	return json.Marshal(data)
+}
+
+// yamlToJSON converts maps with interface{} keys (given by YAML decoder) to maps with string keys
+func yamlToJSON(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{})
+		for key, val := range v {
+			m[yamlKey(key)] = yamlToJSON(val)
+		}
+		return m
+	case []interface{}:
+		for i, val := range v {
+			v[i] = yamlToJSON(val)
+		}
+	}
+	return data
+}
+
+// yamlKey converts YAML keys to strings
+func yamlKey(key interface{}) string {
+	if s, ok := key.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(key)
+	return string(b)
+}
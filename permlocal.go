@@ -0,0 +1,99 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+// TourLength computes the length of the closed tour defined by the permutation a
+//  D -- distance matrix [n][n]
+func TourLength(a []int, D [][]float64) (l float64) {
+	n := len(a)
+	for i := 0; i < n; i++ {
+		l += D[a[i]][a[(i+1)%n]]
+	}
+	return
+}
+
+// TwoOpt improves the closed tour a by means of 2-opt moves until no improving move is found
+//  D -- distance matrix [n][n]
+//  Output: modified tour 'a' and whether the tour has been improved or not
+//  Example:
+//     a = 0 1 2 3 4 5    i = 0, j = 3
+//     edges (0,1) and (3,4) are replaced by (0,3) and (1,4) by reversing a[1:4]
+//     a = 0 3 2 1 4 5
+func TwoOpt(a []int, D [][]float64) (improved bool) {
+	n := len(a)
+	if n < 4 {
+		return
+	}
+	for {
+		found := false
+		for i := 0; i < n-2; i++ {
+			for j := i + 2; j < n; j++ {
+				if i == 0 && j == n-1 {
+					continue // edges are adjacent
+				}
+				p, q := a[i], a[i+1]
+				r, s := a[j], a[(j+1)%n]
+				δ := D[p][r] + D[q][s] - D[p][q] - D[r][s]
+				if δ < -1e-12 {
+					mtIntInv(a, i+1, j+1)
+					found = true
+				}
+			}
+		}
+		if !found {
+			return
+		}
+		improved = true
+	}
+}
+
+// OrOpt improves the closed tour a by moving segments of 1, 2 or 3 consecutive items to other
+// positions in the tour until no improving move is found
+//  D -- distance matrix [n][n]
+//  Output: modified tour 'a' and whether the tour has been improved or not
+//  Note: the tour may be rotated; i.e. a[0] may change
+//  Example:
+//     a = 0 1 2 3 4 5 6    segment = 1 2, inserted between 4 and 5
+//     a = 3 4 1 2 5 6 0
+func OrOpt(a []int, D [][]float64) (improved bool) {
+	n := len(a)
+	if n < 5 {
+		return
+	}
+	seg := make([]int, 3)
+	rem := make([]int, n)
+	for {
+		found := false
+		for L := 1; L <= 3 && !found; L++ {
+			for i := 0; i < n && !found; i++ {
+				p := a[(i+n-1)%n]
+				s1, s2 := a[i], a[(i+L-1)%n]
+				nx := a[(i+L)%n]
+				gain := D[p][s1] + D[s2][nx] - D[p][nx]
+				for k := 0; k < L; k++ {
+					seg[k] = a[(i+k)%n]
+				}
+				for k := 0; k < n-L; k++ { // remaining items starting after segment
+					rem[k] = a[(i+L+k)%n]
+				}
+				for k := 0; k < n-L-1; k++ { // insert between rem[k] and rem[k+1]
+					c, d := rem[k], rem[k+1]
+					cost := D[c][s1] + D[s2][d] - D[c][d]
+					if cost < gain-1e-12 {
+						m := copy(a, rem[:k+1])
+						m += copy(a[m:], seg[:L])
+						copy(a[m:], rem[k+1:n-L])
+						found = true
+						break
+					}
+				}
+			}
+		}
+		if !found {
+			return
+		}
+		improved = true
+	}
+}
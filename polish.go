@@ -0,0 +1,224 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/la"
+	"github.com/cpmech/gosl/utl"
+)
+
+// PolishRes holds the results of gradient-based polishing
+type PolishRes struct {
+	Done   bool    // polishing has been performed
+	Ova0   float64 // objective value before polishing
+	Ova1   float64 // objective value after polishing
+	Viol0  float64 // constraint violation (sum of out-of-range values) before polishing
+	Viol1  float64 // constraint violation (sum of out-of-range values) after polishing
+	Nit    int     // number of outer (augmented Lagrangian) iterations
+	Nfeval int     // number of function evaluations (gradients are not counted)
+}
+
+// String returns a summary of the polishing results
+func (o PolishRes) String() string {
+	return io.Sf("polishing: f = %g → %g (Δ = %g), violation = %g → %g, nit = %d, nfeval = %d",
+		o.Ova0, o.Ova1, o.Ova1-o.Ova0, o.Viol0, o.Viol1, o.Nit, o.Nfeval)
+}
+
+// Polish refines the best (feasible) solution using the gradients given by MinGrad
+//  Note: an augmented Lagrangian method is employed with a projected BFGS quasi-Newton method for
+//        the inner minimisation. Only single-objective problems with fixed-length floats that are
+//        not binary encoded are considered; ints are kept fixed. Periodic floats (see FltBry) are
+//        wrapped and the other floats are projected onto bounds. Discrete floats are snapped to
+//        their sets at the end. The polished solution replaces the best one only if it dominates it
+//        (raw objective values are compared, also in lexicographic mode)
+//  Output: results are also saved in o.Polished. Nfeval is updated
+//  Reference:
+//   [1] Nocedal J and Wright SJ. Numerical Optimization. 2nd Edition. Springer; 2006.
+//       Chapter 17 (augmented Lagrangian) and Chapter 6 (BFGS)
+func (o *Optimiser) Polish() (res PolishRes) {
+
+	// check
+	if o.MinGrad == nil || o.MinProb == nil {
+		chk.Panic("polishing requires both MinProb and MinGrad")
+	}
+	if o.Nf != 1 || o.Nflt < 1 || o.VarLen || o.FltBits > 0 {
+		chk.Panic("polishing is only available for single-objective problems with fixed-length floats that are not binary encoded")
+	}
+
+	// best solution: feasible or least violation
+	best, _ := GetBestFeasible(o, 0)
+	if best == nil {
+		sols := make([]*Solution, len(o.Solutions))
+		copy(sols, o.Solutions)
+		SortSolutions(sols, SortKey{Type: KeyOor}, SortKey{Type: KeyOva})
+		best = sols[0]
+	}
+	res.Ova0, res.Viol0 = best.Ova[0], best.Violation()
+
+	// auxiliary
+	n, ng, nh := o.Nflt, o.Ng, o.Nh
+	x := make([]float64, n)
+	copy(x, best.Flt)
+	λg := make([]float64, ng) // multipliers of g ≥ 0
+	λh := make([]float64, nh) // multipliers of h = 0
+	μ := o.PolMu
+	F, G, H := o.F[0], o.G[0], o.H[0]
+	dF, dG, dH := la.MatAlloc(1, n), la.MatAlloc(ng, n), la.MatAlloc(nh, n)
+
+	// augmented Lagrangian and its gradient
+	lagr := func(dL, x []float64) (L float64) {
+		o.MinProb(F, G, H, x, best.Int, 0)
+		res.Nfeval++
+		L = F[0]
+		for i := 0; i < nh; i++ {
+			L += λh[i]*H[i] + μ*H[i]*H[i]/2.0
+		}
+		for i := 0; i < ng; i++ {
+			if G[i]-λg[i]/μ <= 0 {
+				L += -λg[i]*G[i] + μ*G[i]*G[i]/2.0
+			} else {
+				L += -λg[i] * λg[i] / (2.0 * μ)
+			}
+		}
+		if dL == nil {
+			return
+		}
+		o.MinGrad(dF, dG, dH, x, best.Int, 0)
+		copy(dL, dF[0])
+		for i := 0; i < nh; i++ {
+			for j := 0; j < n; j++ {
+				dL[j] += (λh[i] + μ*H[i]) * dH[i][j]
+			}
+		}
+		for i := 0; i < ng; i++ {
+			if G[i]-λg[i]/μ <= 0 {
+				for j := 0; j < n; j++ {
+					dL[j] += (μ*G[i] - λg[i]) * dG[i][j]
+				}
+			}
+		}
+		return
+	}
+
+	// outer iterations
+	for res.Nit = 0; res.Nit < o.PolNit; res.Nit++ {
+		o.polishBfgs(x, lagr)
+		o.MinProb(F, G, H, x, best.Int, 0)
+		res.Nfeval++
+		viol := 0.0
+		for i := 0; i < ng; i++ {
+			viol += math.Max(0, -G[i])
+			λg[i] = math.Max(0, λg[i]-μ*G[i])
+		}
+		for i := 0; i < nh; i++ {
+			viol += math.Abs(H[i])
+			λh[i] += μ * H[i]
+		}
+		if viol < o.PolTol {
+			break
+		}
+		μ = utl.Min(10.0*μ, 1e8)
+	}
+
+	// polished solution
+	T := NewSolution(best.Id, 0, &o.Parameters)
+	best.CopyInto(T)
+	copy(T.Flt, x)
+	o.SnapFlt(T.Flt)
+	o.ObjFunc(T, 0)
+	res.Nfeval++
+	if dom, _ := T.compare(best, false); dom { // raw objective values: buckets of best may be out of date
+		T.CopyInto(best)
+	}
+	res.Ova1, res.Viol1 = best.Ova[0], best.Violation()
+	res.Done = true
+	o.Nfeval += res.Nfeval
+	o.Polished = res
+	return
+}
+
+// polishBfgs minimises L(x) within [FltMin,FltMax] using a projected BFGS method with backtracking
+//  Note: periodic floats are wrapped instead of projected
+//  lagr -- computes L(x) and, if dL != nil, its gradient dL
+//  Output: x is modified
+func (o *Optimiser) polishBfgs(x []float64, lagr func(dL, x []float64) float64) {
+	n := len(x)
+	Hi := la.MatAlloc(n, n) // inverse Hessian approximation
+	for i := 0; i < n; i++ {
+		Hi[i][i] = 1
+	}
+	dL, dLnew, d, xnew := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	s, y, Hy := make([]float64, n), make([]float64, n), make([]float64, n)
+	L := lagr(dL, x)
+	for it := 0; it < o.PolNitBfgs; it++ {
+
+		// search direction
+		la.MatVecMul(d, -1, Hi, dL)
+		slope := la.VecDot(d, dL)
+		if slope >= 0 { // not a descent direction: reset
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					Hi[i][j] = 0
+				}
+				Hi[i][i] = 1
+				d[i] = -dL[i]
+			}
+			slope = -la.VecDot(dL, dL)
+		}
+		if math.Sqrt(-slope) < o.PolTol {
+			return
+		}
+
+		// backtracking (Armijo) line search with projection onto bounds (or wrapping)
+		α, Lnew := 1.0, 0.0
+		for k := 0; k < 30; k++ {
+			for i := 0; i < n; i++ {
+				if o.FltBryKind(i) == "periodic" {
+					xnew[i] = o.FltBoundary(i, x[i]+α*d[i], x[i], o.FltMin[i], o.FltMax[i])
+				} else {
+					xnew[i] = o.EnforceRange(i, x[i]+α*d[i])
+				}
+			}
+			Lnew = lagr(nil, xnew)
+			if Lnew <= L+1e-4*α*slope {
+				break
+			}
+			α /= 2.0
+		}
+		if Lnew > L {
+			return
+		}
+
+		// update
+		Lnew = lagr(dLnew, xnew)
+		for i := 0; i < n; i++ {
+			s[i], y[i] = xnew[i]-x[i], dLnew[i]-dL[i]
+			if o.FltBryKind(i) == "periodic" { // step without wrapping
+				s[i] = α * d[i]
+			}
+		}
+		copy(x, xnew)
+		copy(dL, dLnew)
+		if math.Abs(L-Lnew) < o.PolTol*(1.0+math.Abs(L)) {
+			return
+		}
+		L = Lnew
+		sy := la.VecDot(s, y)
+		if sy <= 1e-12 {
+			continue
+		}
+		la.MatVecMul(Hy, 1, Hi, y)
+		yHy := la.VecDot(y, Hy)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				Hi[i][j] += ((sy+yHy)*s[i]*s[j])/(sy*sy) - (Hy[i]*s[j]+s[i]*Hy[j])/sy
+			}
+		}
+	}
+}
@@ -23,13 +23,15 @@ func GetFeasible(sols []*Solution) (feasible []*Solution) {
 }
 
 // GetBestFeasible returns the best and list of feasible candidates
-// Note: feasible array is sorted by iOva
+// Note: feasible array is sorted by iOva or lexicographically if opt.Lexico
 func GetBestFeasible(opt *Optimiser, iOvaSort int) (best *Solution, feasible []*Solution) {
 	feasible = GetFeasible(opt.Solutions)
 	if len(feasible) == 0 {
 		return
 	}
-	if opt.Nova > 1 { // multi-objective
+	if opt.Lexico { // lexicographic
+		SortSolutions(feasible, opt.LexKeys()...)
+	} else if opt.Nova > 1 { // multi-objective
 		SortByFrontThenOva(feasible, iOvaSort)
 	} else { // single-objective
 		SortByOva(feasible, iOvaSort)
@@ -0,0 +1,712 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+// BBOB returns the k-th noiseless function of the Black-Box Optimization Benchmarking (BBOB/COCO)
+// testbed
+//  k        -- index of function: 1 ≤ k ≤ 24
+//  dim      -- number of floats (dim ≥ 2). The BBOB dimensions are 2, 3, 5, 10, 20 and 40
+//  instance -- instance number (≥ 1) defining the shift of the optimum, the rotations and the
+//              optimal value Fref
+//  Note: the variables are x ∈ [-5,5]^dim. The instances are generated with the random numbers
+//        of the C code of BBOB 2009 (bbobbenchmarks.c). Xref is the optimal solution, except for
+//        f20 whose optimum is only approximately known
+//  Reference: Hansen N, Finck S, Ros R, Auger A (2009) Real-parameter black-box optimization
+//             benchmarking 2009: noiseless functions definitions. INRIA Research Report RR-6829
+func BBOB(k, dim, instance int) (o *Problem) {
+	if k < 1 || k > 24 {
+		chk.Panic("index of BBOB function must be in [1,24]. k=%d is invalid", k)
+	}
+	if dim < 2 {
+		chk.Panic("BBOB functions require at least 2 dimensions. dim=%d is invalid", dim)
+	}
+	if instance < 1 {
+		chk.Panic("instance of BBOB function must be positive. instance=%d is invalid", instance)
+	}
+	b := newBbob(k, dim, instance)
+	o = &Problem{Name: io.Sf("BBOB%d", k), Nf: 1}
+	o.Xmin, o.Xmax = utl.DblVals(dim, -5), utl.DblVals(dim, 5)
+	o.Xref = b.xopt
+	o.Fref = []float64{b.fopt}
+	o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = b.eval(x)
+	}
+	o.Front = func(npts int) [][]float64 {
+		return [][]float64{{b.fopt}}
+	}
+	return
+}
+
+// bbob holds the data of an instance of a BBOB function
+type bbob struct {
+	fid    int         // index of function
+	dim    int         // dimension
+	xopt   []float64   // optimal solution
+	fopt   float64     // optimal value
+	rot1   [][]float64 // rotation matrix ("rotation" in bbobbenchmarks.c)
+	rot2   [][]float64 // another rotation matrix ("rot2" in bbobbenchmarks.c)
+	lin    [][]float64 // linear transformation (rotations and scaling)
+	scales []float64   // scaling factors
+
+	// Gallagher's functions
+	peakVals   []float64   // [npeaks] heights of peaks
+	peakScales [][]float64 // [npeaks][dim] scaling factors of peaks
+	peakLocs   [][]float64 // [npeaks][dim] rotated locations of peaks
+}
+
+// newBbob generates the instance of a BBOB function
+func newBbob(fid, dim, instance int) (o *bbob) {
+	o = &bbob{fid: fid, dim: dim}
+	rseed := fid + 10000*instance
+	switch fid {
+	case 4:
+		rseed = 3 + 10000*instance
+	case 18:
+		rseed = 17 + 10000*instance
+	}
+	o.fopt = bbobFopt(fid, instance)
+	λ := func(cond float64, i int) float64 { // cond^(i/(dim-1))
+		return math.Pow(cond, float64(i)/float64(dim-1))
+	}
+	o.scales = make([]float64, dim)
+	switch fid {
+	case 1, 2, 3, 4, 5, 8:
+		o.xopt = bbobXopt(rseed, dim)
+	case 6, 7, 10, 11, 13, 14, 15, 16, 17, 18, 23:
+		o.xopt = bbobXopt(rseed, dim)
+		o.rot1 = bbobRotation(rseed+1000000, dim)
+		o.rot2 = bbobRotation(rseed, dim)
+	case 12:
+		o.xopt = bbobXopt(rseed+1000000, dim)
+		o.rot1 = bbobRotation(rseed+1000000, dim)
+	case 24:
+		o.rot1 = bbobRotation(rseed+1000000, dim)
+		o.rot2 = bbobRotation(rseed, dim)
+	}
+	switch fid {
+	case 2, 10:
+		for i := 0; i < dim; i++ {
+			o.scales[i] = λ(1e6, i)
+		}
+	case 3:
+		for i := 0; i < dim; i++ {
+			o.scales[i] = λ(math.Sqrt(10), i)
+		}
+	case 4:
+		for i := 0; i < dim; i += 2 {
+			o.xopt[i] = math.Abs(o.xopt[i])
+		}
+		for i := 0; i < dim; i++ {
+			o.scales[i] = λ(math.Sqrt(10), i)
+		}
+	case 5:
+		for i := 0; i < dim; i++ {
+			if o.xopt[i] > 0 {
+				o.xopt[i] = 5
+				o.scales[i] = λ(10, i)
+			} else {
+				o.xopt[i] = -5
+				o.scales[i] = -λ(10, i)
+			}
+		}
+	case 6, 13, 15:
+		o.lin = bbobLinear(o.rot1, o.rot2, func(i int) float64 { return λ(math.Sqrt(10), i) })
+	case 7:
+		for i := 0; i < dim; i++ {
+			o.scales[i] = λ(math.Sqrt(10), i)
+		}
+	case 8:
+		for i := 0; i < dim; i++ {
+			o.xopt[i] *= 0.75
+		}
+	case 9, 19:
+		s := math.Max(1, math.Sqrt(float64(dim))/8)
+		o.rot1 = bbobRotation(rseed, dim)
+		o.lin = make([][]float64, dim)
+		for i := 0; i < dim; i++ {
+			o.lin[i] = make([]float64, dim)
+			for j := 0; j < dim; j++ {
+				o.lin[i][j] = s * o.rot1[i][j]
+			}
+		}
+		o.xopt = make([]float64, dim)
+		for i := 0; i < dim; i++ {
+			for j := 0; j < dim; j++ {
+				o.xopt[i] += o.lin[j][i] * 0.5 / s / s
+			}
+		}
+	case 16:
+		o.lin = bbobLinear(o.rot1, o.rot2, func(i int) float64 { return λ(0.1, i) })
+	case 17:
+		for i := 0; i < dim; i++ {
+			o.scales[i] = λ(math.Sqrt(10), i)
+		}
+	case 18:
+		for i := 0; i < dim; i++ {
+			o.scales[i] = λ(math.Sqrt(1000), i)
+		}
+	case 20:
+		u := bbobUnif(dim, rseed)
+		o.xopt = make([]float64, dim)
+		for i := 0; i < dim; i++ {
+			o.xopt[i] = 0.5 * 4.2096874637
+			if u[i]-0.5 < 0 {
+				o.xopt[i] *= -1
+			}
+			o.scales[i] = λ(math.Sqrt(10), i)
+		}
+	case 21:
+		o.gallagher(rseed, 101, math.Sqrt(1000), 5)
+	case 22:
+		o.gallagher(rseed, 21, 1000, 4.9)
+	case 23:
+		o.lin = bbobLinear(o.rot1, o.rot2, func(i int) float64 { return λ(10, i) })
+	case 24:
+		u := bbobGauss(dim, rseed)
+		o.xopt = make([]float64, dim)
+		for i := 0; i < dim; i++ {
+			o.xopt[i] = 0.5 * 2.5
+			if u[i] < 0 {
+				o.xopt[i] *= -1
+			}
+		}
+		o.lin = bbobLinear(o.rot1, o.rot2, func(i int) float64 { return λ(10, i) })
+	}
+	return
+}
+
+// gallagher generates the peaks of Gallagher's functions
+//  npeaks -- number of peaks
+//  cond0  -- square root of the conditioning of the global peak
+//  w      -- the peaks are located in [-w,w]^dim (before rotation); the global peak in 0.8·[-w,w]^dim
+func (o *bbob) gallagher(rseed, npeaks int, cond0, w float64) {
+	dim := o.dim
+	o.rot1 = bbobRotation(rseed, dim)
+
+	// heights and conditioning of peaks
+	perm := bbobArgsort(bbobUnif(npeaks-1, rseed))
+	cond := make([]float64, npeaks)
+	o.peakVals = make([]float64, npeaks)
+	cond[0], o.peakVals[0] = cond0, 10
+	for i := 1; i < npeaks; i++ {
+		cond[i] = math.Pow(1000, float64(perm[i-1])/float64(npeaks-2))
+		o.peakVals[i] = float64(i-1)/float64(npeaks-2)*(9.1-1.1) + 1.1
+	}
+
+	// scaling factors
+	o.peakScales = make([][]float64, npeaks)
+	for i := 0; i < npeaks; i++ {
+		perm = bbobArgsort(bbobUnif(dim, rseed+1000*i))
+		o.peakScales[i] = make([]float64, dim)
+		for j := 0; j < dim; j++ {
+			o.peakScales[i][j] = math.Pow(cond[i], float64(perm[j])/float64(dim-1)-0.5)
+		}
+	}
+
+	// locations
+	u := bbobUnif(dim*npeaks, rseed)
+	o.xopt = make([]float64, dim)
+	o.peakLocs = make([][]float64, npeaks)
+	for i := 0; i < dim; i++ {
+		o.xopt[i] = 0.8 * (2*w*u[i] - w)
+	}
+	for j := 0; j < npeaks; j++ {
+		o.peakLocs[j] = make([]float64, dim)
+		for i := 0; i < dim; i++ {
+			for k := 0; k < dim; k++ {
+				o.peakLocs[j][i] += o.rot1[i][k] * (2*w*u[j*dim+k] - w)
+			}
+			if j == 0 {
+				o.peakLocs[j][i] *= 0.8
+			}
+		}
+	}
+}
+
+// eval evaluates the BBOB function
+func (o *bbob) eval(x []float64) (f float64) {
+	dim := o.dim
+	D := float64(dim)
+	z := make([]float64, dim)
+	dx := make([]float64, dim)
+	if o.xopt != nil {
+		for i := 0; i < dim; i++ {
+			dx[i] = x[i] - o.xopt[i]
+		}
+	}
+	switch o.fid {
+
+	// sphere
+	case 1:
+		for _, v := range dx {
+			f += v * v
+		}
+
+	// separable ellipsoid
+	case 2:
+		for i, v := range dx {
+			v = bbobTosc(v)
+			f += o.scales[i] * v * v
+		}
+
+	// separable Rastrigin
+	case 3:
+		for i, v := range dx {
+			z[i] = bbobTosc(v)
+		}
+		bbobTasy(z, 0.2)
+		for i := range z {
+			z[i] *= o.scales[i]
+		}
+		f = bbobRastrigin(z)
+
+	// Büche-Rastrigin
+	case 4:
+		for i, v := range dx {
+			z[i] = bbobTosc(v)
+			if i%2 == 0 && z[i] > 0 {
+				z[i] *= 10
+			}
+			z[i] *= o.scales[i]
+		}
+		f = bbobRastrigin(z) + 100*bbobPenalty(x)
+
+	// linear slope
+	case 5:
+		for i := 0; i < dim; i++ {
+			zi := x[i]
+			if o.xopt[i]*x[i] >= 25 {
+				zi = o.xopt[i]
+			}
+			f += 5*math.Abs(o.scales[i]) - o.scales[i]*zi
+		}
+
+	// attractive sector
+	case 6:
+		z = bbobMatVec(o.lin, dx)
+		for i, v := range z {
+			if v*o.xopt[i] > 0 {
+				v *= 100
+			}
+			f += v * v
+		}
+		f = math.Pow(bbobTosc(f), 0.9)
+
+	// step ellipsoid
+	case 7:
+		zhat := bbobMatVec(o.rot2, dx)
+		for i := range zhat {
+			zhat[i] *= o.scales[i]
+		}
+		x1 := zhat[0]
+		for i, v := range zhat {
+			if math.Abs(v) > 0.5 {
+				zhat[i] = math.Floor(v + 0.5)
+			} else {
+				zhat[i] = math.Floor(10*v+0.5) / 10
+			}
+		}
+		z = bbobMatVec(o.rot1, zhat)
+		for i, v := range z {
+			f += math.Pow(100, float64(i)/(D-1)) * v * v
+		}
+		f = 0.1*math.Max(1e-4*math.Abs(x1), f) + bbobPenalty(x)
+
+	// Rosenbrock
+	case 8:
+		s := math.Max(1, math.Sqrt(D)/8)
+		for i, v := range dx {
+			z[i] = s*v + 1
+		}
+		f = bbobRosenbrock(z)
+
+	// rotated Rosenbrock
+	case 9:
+		z = bbobMatVec(o.lin, x)
+		for i := range z {
+			z[i] += 0.5
+		}
+		f = bbobRosenbrock(z)
+
+	// ellipsoid
+	case 10:
+		z = bbobMatVec(o.rot1, dx)
+		for i, v := range z {
+			v = bbobTosc(v)
+			f += o.scales[i] * v * v
+		}
+
+	// discus
+	case 11:
+		z = bbobMatVec(o.rot1, dx)
+		for i, v := range z {
+			v = bbobTosc(v)
+			if i == 0 {
+				f += 1e6 * v * v
+			} else {
+				f += v * v
+			}
+		}
+
+	// bent cigar
+	case 12:
+		y := bbobMatVec(o.rot1, dx)
+		bbobTasy(y, 0.5)
+		z = bbobMatVec(o.rot1, y)
+		f = z[0] * z[0]
+		for _, v := range z[1:] {
+			f += 1e6 * v * v
+		}
+
+	// sharp ridge
+	case 13:
+		z = bbobMatVec(o.lin, dx)
+		for _, v := range z[1:] {
+			f += v * v
+		}
+		f = z[0]*z[0] + 100*math.Sqrt(f)
+
+	// different powers
+	case 14:
+		z = bbobMatVec(o.rot1, dx)
+		for i, v := range z {
+			f += math.Pow(math.Abs(v), 2+4*float64(i)/(D-1))
+		}
+		f = math.Sqrt(f)
+
+	// Rastrigin
+	case 15:
+		y := bbobMatVec(o.rot1, dx)
+		for i, v := range y {
+			y[i] = bbobTosc(v)
+		}
+		bbobTasy(y, 0.2)
+		f = bbobRastrigin(bbobMatVec(o.lin, y))
+
+	// Weierstrass
+	case 16:
+		y := bbobMatVec(o.rot1, dx)
+		for i, v := range y {
+			y[i] = bbobTosc(v)
+		}
+		z = bbobMatVec(o.lin, y)
+		f0 := 0.0
+		for k := 0; k < 12; k++ {
+			f0 += math.Pow(0.5, float64(k)) * math.Cos(math.Pi*math.Pow(3, float64(k)))
+		}
+		for _, v := range z {
+			for k := 0; k < 12; k++ {
+				f += math.Pow(0.5, float64(k)) * math.Cos(2*math.Pi*math.Pow(3, float64(k))*(v+0.5))
+			}
+		}
+		f = 10*math.Pow(f/D-f0, 3) + 10/D*bbobPenalty(x)
+
+	// Schaffers F7
+	case 17, 18:
+		y := bbobMatVec(o.rot1, dx)
+		bbobTasy(y, 0.5)
+		z = bbobMatVec(o.rot2, y)
+		for i := range z {
+			z[i] *= o.scales[i]
+		}
+		for i := 0; i < dim-1; i++ {
+			s := z[i]*z[i] + z[i+1]*z[i+1]
+			f += math.Pow(s, 0.25) * (math.Pow(math.Sin(50*math.Pow(s, 0.1)), 2) + 1)
+		}
+		f = math.Pow(f/(D-1), 2) + 10*bbobPenalty(x)
+
+	// composite Griewank-Rosenbrock F8F2
+	case 19:
+		z = bbobMatVec(o.lin, x)
+		for i := range z {
+			z[i] += 0.5
+		}
+		for i := 0; i < dim-1; i++ {
+			s := 100*math.Pow(z[i]*z[i]-z[i+1], 2) + math.Pow(1-z[i], 2)
+			f += s/4000 - math.Cos(s)
+		}
+		f = 10 + 10*f/(D-1)
+
+	// Schwefel x·sin(x)
+	case 20:
+		xhat := make([]float64, dim)
+		for i := 0; i < dim; i++ {
+			xhat[i] = 2 * x[i]
+			if o.xopt[i] < 0 {
+				xhat[i] *= -1
+			}
+		}
+		z[0] = xhat[0]
+		for i := 1; i < dim; i++ {
+			z[i] = xhat[i] + 0.25*(xhat[i-1]-2*math.Abs(o.xopt[i-1]))
+		}
+		pen := 0.0
+		for i := 0; i < dim; i++ {
+			z[i] = 100 * (o.scales[i]*(z[i]-2*math.Abs(o.xopt[i])) + 2*math.Abs(o.xopt[i]))
+			if t := math.Abs(z[i]) - 500; t > 0 {
+				pen += t * t
+			}
+			f += z[i] * math.Sin(math.Sqrt(math.Abs(z[i])))
+		}
+		f = 0.01*(418.9828872724339-f/D) + 0.01*pen
+
+	// Gallagher's Gaussian peaks
+	case 21, 22:
+		z = bbobMatVec(o.rot1, x)
+		fmax := 0.0
+		for i, loc := range o.peakLocs {
+			sum := 0.0
+			for j, v := range z {
+				sum += o.peakScales[i][j] * (v - loc[j]) * (v - loc[j])
+			}
+			fmax = math.Max(fmax, o.peakVals[i]*math.Exp(-0.5/D*sum))
+		}
+		f = math.Pow(bbobTosc(10-fmax), 2) + bbobPenalty(x)
+
+	// Katsuura
+	case 23:
+		z = bbobMatVec(o.lin, dx)
+		f = 1
+		for i, v := range z {
+			sum := 0.0
+			for j := 1; j <= 32; j++ {
+				p := math.Pow(2, float64(j))
+				sum += math.Abs(p*v-math.Round(p*v)) / p
+			}
+			f *= 1 + float64(i+1)*sum
+		}
+		f = 10/D/D*(math.Pow(f, 10/math.Pow(D, 1.2))-1) + bbobPenalty(x)
+
+	// Lunacek bi-Rastrigin
+	case 24:
+		μ0, d := 2.5, 1.0
+		s := 1 - 0.5/(math.Sqrt(D+20)-4.1)
+		μ1 := -math.Sqrt((μ0*μ0 - d) / s)
+		xhat := make([]float64, dim)
+		y := make([]float64, dim)
+		sum0, sum1 := 0.0, 0.0
+		for i := 0; i < dim; i++ {
+			xhat[i] = 2 * x[i]
+			if o.xopt[i] < 0 {
+				xhat[i] *= -1
+			}
+			y[i] = xhat[i] - μ0
+			sum0 += (xhat[i] - μ0) * (xhat[i] - μ0)
+			sum1 += (xhat[i] - μ1) * (xhat[i] - μ1)
+		}
+		z = bbobMatVec(o.lin, y)
+		cos := 0.0
+		for _, v := range z {
+			cos += math.Cos(2 * math.Pi * v)
+		}
+		f = math.Min(sum0, d*D+s*sum1) + 10*(D-cos) + 1e4*bbobPenalty(x)
+	}
+	return f + o.fopt
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// bbobUnif generates n uniform random numbers in (0,1) with the generator of BBOB
+func bbobUnif(n, seed int) (r []float64) {
+	if seed < 0 {
+		seed = -seed
+	}
+	if seed < 1 {
+		seed = 1
+	}
+	next := func(s int) int {
+		t := s / 127773
+		s = 16807*(s-t*127773) - 2836*t
+		if s < 0 {
+			s += 2147483647
+		}
+		return s
+	}
+	var table [32]int
+	for i := 39; i >= 0; i-- {
+		seed = next(seed)
+		if i < 32 {
+			table[i] = seed
+		}
+	}
+	rand := table[0]
+	r = make([]float64, n)
+	for i := 0; i < n; i++ {
+		seed = next(seed)
+		t := rand / 67108865
+		rand = table[t]
+		table[t] = seed
+		r[i] = float64(rand) / 2.147483647e9
+		if r[i] == 0 {
+			r[i] = 1e-99
+		}
+	}
+	return
+}
+
+// bbobGauss generates n normally distributed random numbers with the generator of BBOB
+func bbobGauss(n, seed int) (g []float64) {
+	u := bbobUnif(2*n, seed)
+	g = make([]float64, n)
+	for i := 0; i < n; i++ {
+		g[i] = math.Sqrt(-2*math.Log(u[i])) * math.Cos(2*math.Pi*u[n+i])
+		if g[i] == 0 {
+			g[i] = 1e-99
+		}
+	}
+	return
+}
+
+// bbobXopt generates the optimal solution in [-4,4]^dim
+func bbobXopt(seed, dim int) (xopt []float64) {
+	xopt = bbobUnif(dim, seed)
+	for i, u := range xopt {
+		xopt[i] = 8*math.Floor(1e4*u)/1e4 - 4
+		if xopt[i] == 0 {
+			xopt[i] = -1e-5
+		}
+	}
+	return
+}
+
+// bbobFopt generates the optimal value in [-1000,1000]
+func bbobFopt(fid, instance int) float64 {
+	rseed := fid
+	switch fid {
+	case 4:
+		rseed = 3
+	case 18:
+		rseed = 17
+	}
+	rseed += 10000 * instance
+	g1 := bbobGauss(1, rseed)[0]
+	g2 := bbobGauss(1, rseed+1)[0]
+	return math.Min(1000, math.Max(-1000, math.Round(100*100*g1/g2)/100))
+}
+
+// bbobRotation generates a random orthogonal matrix by the Gram-Schmidt orthonormalisation of
+// the columns of a matrix with normally distributed entries
+func bbobRotation(seed, dim int) (B [][]float64) {
+	g := bbobGauss(dim*dim, seed)
+	B = make([][]float64, dim)
+	for i := 0; i < dim; i++ {
+		B[i] = make([]float64, dim)
+		for j := 0; j < dim; j++ {
+			B[i][j] = g[j*dim+i]
+		}
+	}
+	for i := 0; i < dim; i++ {
+		for j := 0; j < i; j++ {
+			prod := 0.0
+			for k := 0; k < dim; k++ {
+				prod += B[k][i] * B[k][j]
+			}
+			for k := 0; k < dim; k++ {
+				B[k][i] -= prod * B[k][j]
+			}
+		}
+		prod := 0.0
+		for k := 0; k < dim; k++ {
+			prod += B[k][i] * B[k][i]
+		}
+		for k := 0; k < dim; k++ {
+			B[k][i] /= math.Sqrt(prod)
+		}
+	}
+	return
+}
+
+// bbobLinear computes the linear transformation rot1·Λ·rot2 where Λ = diag(λ(i))
+func bbobLinear(rot1, rot2 [][]float64, λ func(i int) float64) (M [][]float64) {
+	dim := len(rot1)
+	M = make([][]float64, dim)
+	for i := 0; i < dim; i++ {
+		M[i] = make([]float64, dim)
+		for j := 0; j < dim; j++ {
+			for k := 0; k < dim; k++ {
+				M[i][j] += rot1[i][k] * λ(k) * rot2[k][j]
+			}
+		}
+	}
+	return
+}
+
+// bbobMatVec returns M·v
+func bbobMatVec(M [][]float64, v []float64) (res []float64) {
+	res = make([]float64, len(M))
+	for i := range M {
+		for j, w := range v {
+			res[i] += M[i][j] * w
+		}
+	}
+	return
+}
+
+// bbobArgsort returns the indices that sort v in increasing order
+func bbobArgsort(v []float64) (idx []int) {
+	idx = utl.IntRange(len(v))
+	sort.SliceStable(idx, func(i, j int) bool { return v[idx[i]] < v[idx[j]] })
+	return
+}
+
+// bbobTosc implements the oscillation transformation
+func bbobTosc(f float64) float64 {
+	switch {
+	case f > 0:
+		t := math.Log(f) / 0.1
+		return math.Pow(math.Exp(t+0.49*(math.Sin(t)+math.Sin(0.79*t))), 0.1)
+	case f < 0:
+		t := math.Log(-f) / 0.1
+		return -math.Pow(math.Exp(t+0.49*(math.Sin(0.55*t)+math.Sin(0.31*t))), 0.1)
+	}
+	return f
+}
+
+// bbobTasy implements the asymmetric transformation
+func bbobTasy(z []float64, β float64) {
+	dim := float64(len(z))
+	for i, v := range z {
+		if v > 0 {
+			z[i] = math.Pow(v, 1+β*float64(i)/(dim-1)*math.Sqrt(v))
+		}
+	}
+}
+
+// bbobPenalty computes the boundary penalty Σ max(0, |x|-5)²
+func bbobPenalty(x []float64) (res float64) {
+	for _, v := range x {
+		if t := math.Abs(v) - 5; t > 0 {
+			res += t * t
+		}
+	}
+	return
+}
+
+// bbobRastrigin computes the Rastrigin function
+func bbobRastrigin(z []float64) float64 {
+	sum1, sum2 := 0.0, 0.0
+	for _, v := range z {
+		sum1 += math.Cos(2 * math.Pi * v)
+		sum2 += v * v
+	}
+	return 10*(float64(len(z))-sum1) + sum2
+}
+
+// bbobRosenbrock computes the Rosenbrock function
+func bbobRosenbrock(z []float64) (res float64) {
+	for i := 0; i < len(z)-1; i++ {
+		res += 100*math.Pow(z[i]*z[i]-z[i+1], 2) + math.Pow(z[i]-1, 2)
+	}
+	return
+}
@@ -0,0 +1,550 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+// CEC06 returns the k-th constrained single-objective problem (g01-g24) of the CEC 2006 competition
+//  k -- index of problem: 1 ≤ k ≤ 24
+//  Note: the functions are ported from the C code of the competition (fcnsuite.c) where
+//        constraints are g ≤ 0; hence, the inequality constraints are negated here. Maximisation
+//        problems are converted into minimisation ones. Equality constraints are satisfied if
+//        |h| ≤ EpsH = 1e-4. The best known solution of g20 is slightly infeasible. Lower bounds
+//        equal to zero are replaced by small numbers where the functions would be undefined
+//  Reference: Liang JJ, Runarsson TP, Mezura-Montes E, Clerc M, Suganthan PN, Coello Coello CA,
+//             Deb K (2006) Problem definitions and evaluation criteria for the CEC 2006 special
+//             session on constrained real-parameter optimization. Technical Report, Nanyang
+//             Technological University, Singapore
+func CEC06(k int) (o *Problem) {
+	if k < 1 || k > 24 {
+		chk.Panic("index of CEC06 problem must be in [1,24]. k=%d is invalid", k)
+	}
+	o = &Problem{Name: io.Sf("G%02d", k), Nf: 1, EpsH: 1e-4}
+	var fcn func(f, g, h, x []float64)
+	switch k {
+	case 1:
+		o.Ng = 9
+		o.Xmin, o.Xmax = utl.DblVals(13, 0), utl.DblVals(13, 1)
+		o.Xmax[9], o.Xmax[10], o.Xmax[11] = 100, 100, 100
+		o.Xref = []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 3, 3, 1}
+		o.Fref = []float64{-15}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = 5*(x[0]+x[1]+x[2]+x[3]) - 5*(x[0]*x[0]+x[1]*x[1]+x[2]*x[2]+x[3]*x[3])
+			for j := 4; j < 13; j++ {
+				f[0] -= x[j]
+			}
+			g[0] = 2*x[0] + 2*x[1] + x[9] + x[10] - 10
+			g[1] = 2*x[0] + 2*x[2] + x[9] + x[11] - 10
+			g[2] = 2*x[1] + 2*x[2] + x[10] + x[11] - 10
+			g[3] = -8*x[0] + x[9]
+			g[4] = -8*x[1] + x[10]
+			g[5] = -8*x[2] + x[11]
+			g[6] = -2*x[3] - x[4] + x[9]
+			g[7] = -2*x[5] - x[6] + x[10]
+			g[8] = -2*x[7] - x[8] + x[11]
+		}
+	case 2:
+		o.Ng = 2
+		o.Xmin, o.Xmax = utl.DblVals(20, 1e-100), utl.DblVals(20, 10)
+		o.Xref = []float64{3.16246061572185, 3.12833142812967, 3.09479212988791, 3.06145059523469, 3.02792915885555, 2.99382606701730, 2.95866871765285, 2.92184227312450, 0.49482511456933, 0.48835711005490, 0.48231642711865, 0.47664475092742, 0.47129550835493, 0.46623099264167, 0.46142004984199, 0.45683664767217, 0.45245876903267, 0.44826762241853, 0.44424700958760, 0.44038285956317}
+		o.Fref = []float64{-0.80361910412559}
+		fcn = func(f, g, h, x []float64) {
+			f1, f2, f3, g1, g2 := 0.0, 1.0, 0.0, 1.0, 0.0
+			for j, v := range x {
+				c := math.Cos(v)
+				f1 += math.Pow(c, 4)
+				f2 *= c * c
+				f3 += float64(j+1) * v * v
+				g1 *= v
+				g2 += v
+			}
+			f[0] = -math.Abs((f1 - 2*f2) / math.Sqrt(f3))
+			g[0] = 0.75 - g1
+			g[1] = g2 - 7.5*float64(len(x))
+		}
+	case 3:
+		o.Nh = 1
+		o.Xmin, o.Xmax = utl.DblVals(10, 0), utl.DblVals(10, 1)
+		o.Xref = []float64{0.31624357647283069, 0.316243577414338339, 0.316243578012345927, 0.316243575664017895, 0.316243578205526066, 0.31624357738855069, 0.316243575472949512, 0.316243577164883938, 0.316243578155920302, 0.316243576147374916}
+		o.Fref = []float64{-1.00050010001000}
+		fcn = func(f, g, h, x []float64) {
+			c := math.Sqrt(float64(len(x)))
+			f1, f2 := 1.0, 0.0
+			for _, v := range x {
+				f1 *= c * v
+				f2 += v * v
+			}
+			f[0] = -f1
+			h[0] = f2 - 1
+		}
+	case 4:
+		o.Ng = 6
+		o.Xmin = []float64{78, 33, 27, 27, 27}
+		o.Xmax = []float64{102, 45, 45, 45, 45}
+		o.Xref = []float64{78, 33, 29.9952560256815985, 45, 36.7758129057882073}
+		o.Fref = []float64{-3.066553867178332e+04}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = 5.3578547*x[2]*x[2] + 0.8356891*x[0]*x[4] + 37.293239*x[0] - 40792.141
+			u := 85.334407 + 0.0056858*x[1]*x[4] + 0.0006262*x[0]*x[3] - 0.0022053*x[2]*x[4]
+			v := 80.51249 + 0.0071317*x[1]*x[4] + 0.0029955*x[0]*x[1] + 0.0021813*x[2]*x[2]
+			w := 9.300961 + 0.0047026*x[2]*x[4] + 0.0012547*x[0]*x[2] + 0.0019085*x[2]*x[3]
+			g[0], g[1] = u-92, -u
+			g[2], g[3] = v-110, 90-v
+			g[4], g[5] = w-25, 20-w
+		}
+	case 5:
+		o.Ng, o.Nh = 2, 3
+		o.Xmin = []float64{0, 0, -0.55, -0.55}
+		o.Xmax = []float64{1200, 1200, 0.55, 0.55}
+		o.Xref = []float64{679.945148297028709, 1026.06697600004691, 0.118876369094410433, -0.39623348521517826}
+		o.Fref = []float64{5126.4967140071}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = 3*x[0] + 0.000001*math.Pow(x[0], 3) + 2*x[1] + (0.000002/3)*math.Pow(x[1], 3)
+			g[0] = -x[3] + x[2] - 0.55
+			g[1] = -x[2] + x[3] - 0.55
+			h[0] = 1000*math.Sin(-x[2]-0.25) + 1000*math.Sin(-x[3]-0.25) + 894.8 - x[0]
+			h[1] = 1000*math.Sin(x[2]-0.25) + 1000*math.Sin(x[2]-x[3]-0.25) + 894.8 - x[1]
+			h[2] = 1000*math.Sin(x[3]-0.25) + 1000*math.Sin(x[3]-x[2]-0.25) + 1294.8
+		}
+	case 6:
+		o.Ng = 2
+		o.Xmin = []float64{13, 0}
+		o.Xmax = []float64{100, 100}
+		o.Xref = []float64{14.0950000000000006400000000, 0.8429607892154795668000000}
+		o.Fref = []float64{-6961.81387558015}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = math.Pow(x[0]-10, 3) + math.Pow(x[1]-20, 3)
+			g[0] = 100 - (x[0]-5)*(x[0]-5) - (x[1]-5)*(x[1]-5)
+			g[1] = (x[0]-6)*(x[0]-6) + (x[1]-5)*(x[1]-5) - 82.81
+		}
+	case 7:
+		o.Ng = 8
+		o.Xmin, o.Xmax = utl.DblVals(10, -10), utl.DblVals(10, 10)
+		o.Xref = []float64{2.17199634142692, 2.3636830416034, 8.77392573913157, 5.09598443745173, 0.990654756560493, 1.43057392853463, 1.32164415364306, 9.82872576524495, 8.2800915887356, 8.3759266477347}
+		o.Fref = []float64{24.30620906818}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = x[0]*x[0] + x[1]*x[1] + x[0]*x[1] - 14*x[0] - 16*x[1] + (x[2]-10)*(x[2]-10) +
+				4*(x[3]-5)*(x[3]-5) + (x[4]-3)*(x[4]-3) + 2*(x[5]-1)*(x[5]-1) + 5*x[6]*x[6] +
+				7*(x[7]-11)*(x[7]-11) + 2*(x[8]-10)*(x[8]-10) + (x[9]-7)*(x[9]-7) + 45
+			g[0] = -105 + 4*x[0] + 5*x[1] - 3*x[6] + 9*x[7]
+			g[1] = 10*x[0] - 8*x[1] - 17*x[6] + 2*x[7]
+			g[2] = -8*x[0] + 2*x[1] + 5*x[8] - 2*x[9] - 12
+			g[3] = 3*(x[0]-2)*(x[0]-2) + 4*(x[1]-3)*(x[1]-3) + 2*x[2]*x[2] - 7*x[3] - 120
+			g[4] = 5*x[0]*x[0] + 8*x[1] + (x[2]-6)*(x[2]-6) - 2*x[3] - 40
+			g[5] = x[0]*x[0] + 2*(x[1]-2)*(x[1]-2) - 2*x[0]*x[1] + 14*x[4] - 6*x[5]
+			g[6] = 0.5*(x[0]-8)*(x[0]-8) + 2*(x[1]-4)*(x[1]-4) + 3*x[4]*x[4] - x[5] - 30
+			g[7] = -3*x[0] + 6*x[1] + 12*(x[8]-8)*(x[8]-8) - 7*x[9]
+		}
+	case 8:
+		o.Ng = 2
+		o.Xmin, o.Xmax = utl.DblVals(2, 0), utl.DblVals(2, 10)
+		o.Xref = []float64{1.22797135260752599, 4.24537336612274885}
+		o.Fref = []float64{-0.0958250414180359}
+		fcn = func(f, g, h, x []float64) {
+			a := 8 * math.Pi * math.Pi * math.Pi // limit of sin³(2πx0)/x0³ as x0 → 0
+			if x[0] != 0 {
+				a = math.Pow(math.Sin(2*math.Pi*x[0])/x[0], 3)
+			}
+			b := 2 * math.Pi // limit of sin(2πx1)/(x0+x1) as x1 → 0 with x0 = 0
+			if x[0]+x[1] != 0 {
+				b = math.Sin(2*math.Pi*x[1]) / (x[0] + x[1])
+			}
+			f[0] = -a * b
+			g[0] = x[0]*x[0] - x[1] + 1
+			g[1] = 1 - x[0] + (x[1]-4)*(x[1]-4)
+		}
+	case 9:
+		o.Ng = 4
+		o.Xmin, o.Xmax = utl.DblVals(7, -10), utl.DblVals(7, 10)
+		o.Xref = []float64{2.33049935147405174, 1.95137236847114592, -0.477541399510615805, 4.36572624923625874, -0.624486959100388983, 1.03813099410962173, 1.5942266780671519}
+		o.Fref = []float64{680.630057374402}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = (x[0]-10)*(x[0]-10) + 5*(x[1]-12)*(x[1]-12) + math.Pow(x[2], 4) + 3*(x[3]-11)*(x[3]-11) +
+				10*math.Pow(x[4], 6) + 7*x[5]*x[5] + math.Pow(x[6], 4) - 4*x[5]*x[6] - 10*x[5] - 8*x[6]
+			g[0] = -127 + 2*x[0]*x[0] + 3*math.Pow(x[1], 4) + x[2] + 4*x[3]*x[3] + 5*x[4]
+			g[1] = -282 + 7*x[0] + 3*x[1] + 10*x[2]*x[2] + x[3] - x[4]
+			g[2] = -196 + 23*x[0] + x[1]*x[1] + 6*x[5]*x[5] - 8*x[6]
+			g[3] = 4*x[0]*x[0] + x[1]*x[1] - 3*x[0]*x[1] + 2*x[2]*x[2] + 5*x[5] - 11*x[6]
+		}
+	case 10:
+		o.Ng = 6
+		o.Xmin = []float64{100, 1000, 1000, 10, 10, 10, 10, 10}
+		o.Xmax = []float64{10000, 10000, 10000, 1000, 1000, 1000, 1000, 1000}
+		o.Xref = []float64{579.306685017979589, 1359.97067807935605, 5109.97065743133317, 182.01769963061534, 295.601173702746792, 217.982300369384632, 286.41652592786852, 395.601173702746735}
+		o.Fref = []float64{7049.24802052867}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = x[0] + x[1] + x[2]
+			g[0] = -1 + 0.0025*(x[3]+x[5])
+			g[1] = -1 + 0.0025*(x[4]+x[6]-x[3])
+			g[2] = -1 + 0.01*(x[7]-x[4])
+			g[3] = -x[0]*x[5] + 833.33252*x[3] + 100*x[0] - 83333.333
+			g[4] = -x[1]*x[6] + 1250*x[4] + x[1]*x[3] - 1250*x[3]
+			g[5] = -x[2]*x[7] + 1250000 + x[2]*x[4] - 2500*x[4]
+		}
+	case 11:
+		o.Nh = 1
+		o.Xmin, o.Xmax = utl.DblVals(2, -1), utl.DblVals(2, 1)
+		o.Xref = []float64{-0.707036070037170616, 0.500000004333606807}
+		o.Fref = []float64{0.7499}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = x[0]*x[0] + (x[1]-1)*(x[1]-1)
+			h[0] = x[1] - x[0]*x[0]
+		}
+	case 12:
+		o.Ng = 1
+		o.Xmin, o.Xmax = utl.DblVals(3, 0), utl.DblVals(3, 10)
+		o.Xref = []float64{5, 5, 5}
+		o.Fref = []float64{-1}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = -(100 - (x[0]-5)*(x[0]-5) - (x[1]-5)*(x[1]-5) - (x[2]-5)*(x[2]-5)) / 100
+			g[0] = (x[0]-1)*(x[0]-1) + (x[1]-1)*(x[1]-1) + (x[2]-1)*(x[2]-1) - 0.0625
+			for p := 1.0; p <= 9; p++ {
+				for q := 1.0; q <= 9; q++ {
+					for r := 1.0; r <= 9; r++ {
+						g[0] = math.Min(g[0], (x[0]-p)*(x[0]-p)+(x[1]-q)*(x[1]-q)+(x[2]-r)*(x[2]-r)-0.0625)
+					}
+				}
+			}
+		}
+	case 13:
+		o.Nh = 3
+		o.Xmin = []float64{-2.3, -2.3, -3.2, -3.2, -3.2}
+		o.Xmax = []float64{2.3, 2.3, 3.2, 3.2, 3.2}
+		o.Xref = []float64{-1.71714224003, 1.59572124049468, 1.8272502406271, -0.763659881912867, -0.76365986736498}
+		o.Fref = []float64{0.053941514041898}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = math.Exp(x[0] * x[1] * x[2] * x[3] * x[4])
+			h[0] = x[0]*x[0] + x[1]*x[1] + x[2]*x[2] + x[3]*x[3] + x[4]*x[4] - 10
+			h[1] = x[1]*x[2] - 5*x[3]*x[4]
+			h[2] = math.Pow(x[0], 3) + math.Pow(x[1], 3) + 1
+		}
+	case 14:
+		o.Nh = 3
+		o.Xmin, o.Xmax = utl.DblVals(10, 1e-100), utl.DblVals(10, 10)
+		o.Xref = []float64{0.0406684113216282, 0.147721240492452, 0.783205732104114, 0.00141433931889084, 0.485293636780388, 0.000693183051556082, 0.0274052040687766, 0.0179509660214818, 0.0373268186859717, 0.0968844604336845}
+		o.Fref = []float64{-47.7648884594915}
+		c := []float64{-6.089, -17.164, -34.054, -5.914, -24.721, -14.986, -24.100, -10.708, -26.662, -22.179}
+		fcn = func(f, g, h, x []float64) {
+			sum := 0.0
+			for _, v := range x {
+				sum += v
+			}
+			f[0] = 0
+			for i, v := range x {
+				f[0] += v * (c[i] + math.Log(v/sum))
+			}
+			h[0] = x[0] + 2*x[1] + 2*x[2] + x[5] + x[9] - 2
+			h[1] = x[3] + 2*x[4] + x[5] + x[6] - 1
+			h[2] = x[2] + x[6] + x[7] + 2*x[8] + x[9] - 1
+		}
+	case 15:
+		o.Nh = 2
+		o.Xmin, o.Xmax = utl.DblVals(3, 0), utl.DblVals(3, 10)
+		o.Xref = []float64{3.51212812611795133, 0.216987510429556135, 3.55217854929179921}
+		o.Fref = []float64{961.715022289961}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = 1000 - x[0]*x[0] - 2*x[1]*x[1] - x[2]*x[2] - x[0]*x[1] - x[0]*x[2]
+			h[0] = x[0]*x[0] + x[1]*x[1] + x[2]*x[2] - 25
+			h[1] = 8*x[0] + 14*x[1] + 7*x[2] - 56
+		}
+	case 16:
+		o.Ng = 38
+		o.Xmin = []float64{704.4148, 68.6, 0, 193, 25}
+		o.Xmax = []float64{906.3855, 288.88, 134.75, 287.0966, 84.1988}
+		o.Xref = []float64{705.174537070090537, 68.5999999999999943, 102.899999999999991, 282.324931593660324, 37.5841164258054832}
+		o.Fref = []float64{-1.90515525853479}
+		fcn = g16
+	case 17:
+		o.Nh = 4
+		o.Xmin = []float64{0, 0, 340, 340, -1000, 0}
+		o.Xmax = []float64{400, 1000, 420, 420, 1000, 0.5236}
+		o.Xref = []float64{201.784467214523659, 99.9999999999999005, 383.071034852773266, 420, -10.9076584514292652, 0.0731482312084287128}
+		o.Fref = []float64{8853.53967480648}
+		fcn = func(f, g, h, x []float64) {
+			a := 1.48477
+			b := 1.47588
+			c := 131.078
+			aux1 := 300 - (x[2]*x[3]*math.Cos(a-x[5])-0.90798*x[2]*x[2]*math.Cos(b))/c
+			aux2 := -(x[2]*x[3]*math.Cos(a+x[5]) - 0.90798*x[3]*x[3]*math.Cos(b)) / c
+			aux5 := -(x[2]*x[3]*math.Sin(a+x[5]) - 0.90798*x[3]*x[3]*math.Sin(b)) / c
+			aux4 := 200 - (x[2]*x[3]*math.Sin(a-x[5])-0.90798*x[2]*x[2]*math.Sin(b))/c
+			c1, c2 := 31.0, 30.0
+			if x[0] < 300 {
+				c1 = 30
+			}
+			if x[1] < 100 {
+				c2 = 28
+			} else if x[1] < 200 {
+				c2 = 29
+			}
+			f[0] = c1*aux1 + c2*aux2
+			h[0] = aux1 - x[0]
+			h[1] = aux2 - x[1]
+			h[2] = aux5 - x[4]
+			h[3] = aux4
+		}
+	case 18:
+		o.Ng = 13
+		o.Xmin, o.Xmax = utl.DblVals(9, -10), utl.DblVals(9, 10)
+		o.Xmin[8], o.Xmax[8] = 0, 20
+		o.Xref = []float64{-0.657776192427943163, -0.153418773482438542, 0.323413871675240938, -0.946257611651304398, -0.657776194376798906, -0.753213434632691414, 0.323413874123576972, -0.346462947962331735, 0.59979466285217542}
+		o.Fref = []float64{-0.866025403784439}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = -0.5 * (x[0]*x[3] - x[1]*x[2] + x[2]*x[8] - x[4]*x[8] + x[4]*x[7] - x[5]*x[6])
+			g[0] = -1 + x[2]*x[2] + x[3]*x[3]
+			g[1] = -1 + x[8]*x[8]
+			g[2] = -1 + x[4]*x[4] + x[5]*x[5]
+			g[3] = -1 + x[0]*x[0] + (x[1]-x[8])*(x[1]-x[8])
+			g[4] = -1 + (x[0]-x[4])*(x[0]-x[4]) + (x[1]-x[5])*(x[1]-x[5])
+			g[5] = -1 + (x[0]-x[6])*(x[0]-x[6]) + (x[1]-x[7])*(x[1]-x[7])
+			g[6] = -1 + (x[2]-x[4])*(x[2]-x[4]) + (x[3]-x[5])*(x[3]-x[5])
+			g[7] = -1 + (x[2]-x[6])*(x[2]-x[6]) + (x[3]-x[7])*(x[3]-x[7])
+			g[8] = -1 + x[6]*x[6] + (x[7]-x[8])*(x[7]-x[8])
+			g[9] = -x[0]*x[3] + x[1]*x[2]
+			g[10] = -x[2] * x[8]
+			g[11] = x[4] * x[8]
+			g[12] = -x[4]*x[7] + x[5]*x[6]
+		}
+	case 19:
+		o.Ng = 5
+		o.Xmin, o.Xmax = utl.DblVals(15, 0), utl.DblVals(15, 10)
+		o.Xref = []float64{1.66991341326291344e-17, 3.95378229282456509e-16, 3.94599045143233784, 1.06036597479721211e-16, 3.2831773458454161, 9.99999999999999822, 1.12829414671605333e-17, 1.2026194599794709e-17, 2.50706276000769697e-15, 2.24624122987970677e-15, 0.370764847417013987, 0.278456024942955571, 0.523838487672241171, 0.388620152510322781, 0.298156764974678579}
+		o.Fref = []float64{32.6555929502463}
+		fcn = g19
+	case 20:
+		o.Ng, o.Nh = 6, 14
+		o.Xmin, o.Xmax = utl.DblVals(24, 0), utl.DblVals(24, 10)
+		o.Xmin[0], o.Xmin[23] = 1e-100, 1e-100 // avoid division by zero
+		o.Xref = []float64{1.28582343498528086e-18, 4.83460302526130664e-34, 0, 0, 6.30459929660781851e-18, 7.57192526201145068e-34, 5.03350698372840437e-34, 9.28268079616618064e-34, 0, 1.76723384525547359e-17, 3.55686101822965701e-34, 2.99413850083471346e-34, 0.158143376337580827, 2.29601774161699833e-19, 1.06106938611042947e-18, 1.31968344319506391e-18, 0.530902525044209539, 0, 2.89148310257773535e-18, 3.34892126180666159e-18, 0, 0.310999974151577319, 5.41244666317833561e-05, 4.84993165246959553e-16}
+		o.Fref = []float64{0.20497940028563}
+		fcn = g20
+	case 21:
+		o.Ng, o.Nh = 1, 5
+		o.Xmin = []float64{0, 0, 0, 100, 6.3, 5.9, 4.5}
+		o.Xmax = []float64{1000, 40, 40, 300, 6.7, 6.4, 6.25}
+		o.Xref = []float64{193.724510070034967, 5.56944131553368433e-27, 17.3191887294084914, 100.047897801386839, 6.68445185362377892, 5.99168428444264833, 6.21451648886070451}
+		o.Fref = []float64{193.724510070035}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = x[0]
+			g[0] = -x[0] + 35*math.Pow(x[1], 0.6) + 35*math.Pow(x[2], 0.6)
+			h[0] = -300*x[2] + 7500*x[4] - 7500*x[5] - 25*x[3]*x[4] + 25*x[3]*x[5] + x[2]*x[3]
+			h[1] = 100*x[1] + 155.365*x[3] + 2500*x[6] - x[1]*x[3] - 25*x[3]*x[6] - 15536.5
+			h[2] = -x[4] + math.Log(-x[3]+900)
+			h[3] = -x[5] + math.Log(x[3]+300)
+			h[4] = -x[6] + math.Log(-2*x[3]+700)
+		}
+	case 22:
+		o.Ng, o.Nh = 1, 19
+		o.Xmin = []float64{0, 0, 0, 0, 0, 0, 0, 100, 100, 100.01, 100, 100, 0, 0, 0, 0.01, 0.01, -4.7, -4.7, -4.7, -4.7, -4.7}
+		o.Xmax = []float64{20000, 1e+6, 1e+6, 1e+6, 4e+7, 4e+7, 4e+7, 299.99, 399.99, 300, 400, 600, 500, 500, 500, 300, 400, 6.25, 6.25, 6.25, 6.25, 6.25}
+		o.Xref = []float64{236.430975504001054, 135.82847151732463, 204.818152544824585, 6446.54654059436416, 3007540.83940215595, 4074188.65771341929, 32918270.5028952882, 130.075408394314167, 170.817294970528621, 299.924591605478554, 399.258113423595205, 330.817294971142758, 184.51831230897065, 248.64670239647424, 127.658546694545862, 269.182627528746707, 160.000016724090955, 5.29788288102680571, 5.13529735903945728, 5.59531526444068827, 5.43444479314453499, 5.07517453535834395}
+		o.Fref = []float64{236.430975504001}
+		fcn = g22
+	case 23:
+		o.Ng, o.Nh = 2, 4
+		o.Xmin = []float64{0, 0, 0, 0, 0, 0, 0, 0, 0.01}
+		o.Xmax = []float64{300, 300, 100, 200, 100, 300, 100, 200, 0.03}
+		o.Xref = []float64{0.00510000000000259465, 99.9947000000000514, 9.01920162996045897e-18, 99.9999000000000535, 0.000100000000027086086, 2.75700683389584542e-14, 99.9999999999999574, 200, 0.0100000100000100008}
+		o.Fref = []float64{-400.055099999999584}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = -9*x[4] - 15*x[7] + 6*x[0] + 16*x[1] + 10*(x[5]+x[6])
+			g[0] = x[8]*x[2] + 0.02*x[5] - 0.025*x[4]
+			g[1] = x[8]*x[3] + 0.02*x[6] - 0.015*x[7]
+			h[0] = x[0] + x[1] - x[2] - x[3]
+			h[1] = 0.03*x[0] + 0.01*x[1] - x[8]*(x[2]+x[3])
+			h[2] = x[2] + x[5] - x[4]
+			h[3] = x[3] + x[6] - x[7]
+		}
+	case 24:
+		o.Ng = 2
+		o.Xmin = []float64{0, 0}
+		o.Xmax = []float64{3, 4}
+		o.Xref = []float64{2.32952019747762, 3.17849307411774}
+		o.Fref = []float64{-5.50801327159536}
+		fcn = func(f, g, h, x []float64) {
+			f[0] = -x[0] - x[1]
+			g[0] = -2*math.Pow(x[0], 4) + 8*math.Pow(x[0], 3) - 8*x[0]*x[0] + x[1] - 2
+			g[1] = -4*math.Pow(x[0], 4) + 32*math.Pow(x[0], 3) - 88*x[0]*x[0] + 96*x[0] + x[1] - 36
+		}
+	}
+
+	// goga convention: g ≥ 0
+	o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+		fcn(f, g, h, x)
+		for i := 0; i < len(g); i++ {
+			g[i] = -g[i]
+		}
+	}
+
+	// best known value
+	o.Front = func(npts int) [][]float64 {
+		return [][]float64{{o.Fref[0]}}
+	}
+	return
+}
+
+// g16 computes the objective and constraints (g ≤ 0) of problem g16
+func g16(f, g, h, x []float64) {
+	var c, y [17]float64
+	x1, x2, x3, x4, x5 := x[0], x[1], x[2], x[3], x[4]
+	y[0] = x2 + x3 + 41.6
+	c[0] = 0.024*x4 - 4.62
+	y[1] = 12.5/c[0] + 12
+	c[1] = 0.0003535*x1*x1 + 0.5311*x1 + 0.08705*y[1]*x1
+	c[2] = 0.052*x1 + 78 + 0.002377*y[1]*x1
+	y[2] = c[1] / c[2]
+	y[3] = 19 * y[2]
+	c[3] = 0.04782*(x1-y[2]) + 0.1956*(x1-y[2])*(x1-y[2])/x2 + 0.6376*y[3] + 1.594*y[2]
+	c[4] = 100 * x2
+	c[5] = x1 - y[2] - y[3]
+	c[6] = 0.950 - c[3]/c[4]
+	y[4] = c[5] * c[6]
+	y[5] = x1 - y[4] - y[3] - y[2]
+	c[7] = (y[4] + y[3]) * 0.995
+	y[6] = c[7] / y[0]
+	y[7] = c[7] / 3798
+	c[8] = y[6] - 0.0663*y[6]/y[7] - 0.3153
+	y[8] = 96.82/c[8] + 0.321*y[0]
+	y[9] = 1.29*y[4] + 1.258*y[3] + 2.29*y[2] + 1.71*y[5]
+	y[10] = 1.71*x1 - 0.452*y[3] + 0.580*y[2]
+	c[9] = 12.3 / 752.3
+	c[10] = 1.75 * y[1] * 0.995 * x1
+	c[11] = 0.995*y[9] + 1998
+	y[11] = c[9]*x1 + c[10]/c[11]
+	y[12] = c[11] - 1.75*y[1]
+	y[13] = 3623 + 64.4*x2 + 58.4*x3 + 146312/(y[8]+x5)
+	c[12] = 0.995*y[9] + 60.8*x2 + 48*x4 - 0.1121*y[13] - 5095
+	y[14] = y[12] / c[12]
+	y[15] = 148000 - 331000*y[14] + 40*y[12] - 61*y[14]*y[12]
+	c[13] = 2324*y[9] - 28740000*y[1]
+	y[16] = 14130000 - 1328*y[9] - 531*y[10] + c[13]/c[11]
+	c[14] = y[12]/y[14] - y[12]/0.52
+	c[15] = 1.104 - 0.72*y[14]
+	c[16] = y[8] + x5
+	f[0] = -(0.0000005843*y[16] - 0.000117*y[13] - 0.1365 - 0.00002358*y[12] - 0.000001502*y[15] -
+		0.0321*y[11] - 0.004324*y[4] - 0.0001*c[14]/c[15] - 37.48*y[1]/c[11])
+	g[0] = -y[3] + (0.28/0.72)*y[4]
+	g[1] = -1.5*x2 + x3
+	g[2] = -21 + 3496*y[1]/c[11]
+	g[3] = -62212/c[16] + 110.6 + y[0]
+	lo := []float64{213.1, 17.505, 11.275, 214.228, 7.458, 0.961, 1.612, 0.146, 107.99, 922.693, 926.832, 18.766, 1072.163, 8961.448, 0.063, 71084.33, 2802713}
+	hi := []float64{405.23, 1053.6667, 35.03, 665.585, 584.463, 265.916, 7.046, 0.222, 273.366, 1286.105, 1444.046, 537.141, 3247.039, 26844.086, 0.386, 140000, 12146108}
+	for i := 0; i < 17; i++ {
+		g[4+2*i] = lo[i] - y[i]
+		g[5+2*i] = y[i] - hi[i]
+	}
+}
+
+// g19 computes the objective and constraints (g ≤ 0) of problem g19
+func g19(f, g, h, x []float64) {
+	a := [10][5]float64{
+		{-16, 2, 0, 1, 0},
+		{0, -2, 0, 0.4, 2},
+		{-3.5, 0, 2, 0, 0},
+		{0, -2, 0, -4, -1},
+		{0, -9, -2, 1, -2.8},
+		{2, 0, -4, 0, 0},
+		{-1, -1, -1, -1, -1},
+		{-1, -2, -3, -2, -1},
+		{1, 2, 3, 4, 5},
+		{1, 1, 1, 1, 1},
+	}
+	b := []float64{-40, -2, -0.25, -4, -4, -1, -40, -60, 5, 1}
+	c := [5][5]float64{
+		{30, -20, -10, 32, -10},
+		{-20, 39, -6, -31, 32},
+		{-10, -6, 10, -6, -10},
+		{32, -31, -6, 39, -20},
+		{-10, 32, -10, -20, 30},
+	}
+	d := []float64{4, 8, 10, 6, 2}
+	e := []float64{-15, -27, -36, -18, -12}
+	y := x[10:]
+	sum1, sum2, sum3 := 0.0, 0.0, 0.0
+	for i := 0; i < 10; i++ {
+		sum1 += b[i] * x[i]
+	}
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			sum2 += c[i][j] * y[i] * y[j]
+		}
+		sum3 += d[i] * math.Pow(y[i], 3)
+	}
+	f[0] = -(sum1 - sum2 - 2*sum3)
+	for j := 0; j < 5; j++ {
+		s1, s2 := 0.0, 0.0
+		for i := 0; i < 5; i++ {
+			s1 += c[i][j] * y[i]
+		}
+		for i := 0; i < 10; i++ {
+			s2 += a[i][j] * x[i]
+		}
+		g[j] = -(2*s1 + 3*d[j]*y[j]*y[j] + e[j] - s2)
+	}
+}
+
+// g20 computes the objective and constraints (g ≤ 0) of problem g20
+func g20(f, g, h, x []float64) {
+	a := []float64{0.0693, 0.0577, 0.05, 0.2, 0.26, 0.55, 0.06, 0.1, 0.12, 0.18, 0.1, 0.09}
+	b := []float64{44.094, 58.12, 58.12, 137.4, 120.9, 170.9, 62.501, 84.94, 133.425, 82.507, 46.07, 60.097}
+	c := []float64{123.7, 31.7, 45.7, 14.7, 84.7, 27.7, 49.7, 7.1, 2.1, 17.7, 0.85, 0.64}
+	d := []float64{31.244, 36.12, 34.784, 92.7, 82.7, 91.6, 56.708, 82.7, 80.8, 64.517, 49.4, 49.1}
+	e := []float64{0.1, 0.3, 0.4, 0.3, 0.6, 0.3}
+	f[0] = 0
+	for j := 0; j < 24; j++ {
+		f[0] += a[j%12] * x[j]
+	}
+	sum1, sum2, sum3, total := 0.0, 0.0, 0.0, 0.0
+	for j := 0; j < 12; j++ {
+		sum1 += x[j] / b[j]
+		sum2 += x[j+12] / b[j]
+		sum3 += x[j] / d[j]
+	}
+	for j := 0; j < 24; j++ {
+		total += x[j]
+	}
+	for i := 0; i < 12; i++ {
+		h[i] = x[i+12]/(b[i]*sum2) - c[i]*x[i]/(40*b[i]*sum1)
+	}
+	h[12] = total - 1
+	h[13] = sum3 + (0.7302*530*(14.7/40))*sum2 - 1.671
+	for j := 0; j < 3; j++ {
+		g[j] = (x[j] + x[j+12]) / (total + e[j])
+	}
+	for j := 3; j < 6; j++ {
+		g[j] = (x[j+3] + x[j+15]) / (total + e[j])
+	}
+}
+
+// g22 computes the objective and constraints (g ≤ 0) of problem g22
+func g22(f, g, h, x []float64) {
+	f[0] = x[0]
+	g[0] = -x[0] + math.Pow(x[1], 0.6) + math.Pow(x[2], 0.6) + math.Pow(x[3], 0.6)
+	h[0] = x[4] - 100000*x[7] + 10000000
+	h[1] = x[5] + 100000*x[7] - 100000*x[8]
+	h[2] = x[6] + 100000*x[8] - 50000000
+	h[3] = x[4] + 100000*x[9] - 33000000
+	h[4] = x[5] + 100000*x[10] - 44000000
+	h[5] = x[6] + 100000*x[11] - 66000000
+	h[6] = x[4] - 120*x[1]*x[12]
+	h[7] = x[5] - 80*x[2]*x[13]
+	h[8] = x[6] - 40*x[3]*x[14]
+	h[9] = x[7] - x[10] + x[15]
+	h[10] = x[8] - x[11] + x[16]
+	h[11] = -x[17] + math.Log(x[9]-100)
+	h[12] = -x[18] + math.Log(-x[7]+300)
+	h[13] = -x[19] + math.Log(x[15])
+	h[14] = -x[20] + math.Log(-x[8]+400)
+	h[15] = -x[21] + math.Log(x[16])
+	h[16] = -x[7] - x[9] + x[12]*x[17] - x[12]*x[18] + 400
+	h[17] = x[7] - x[8] - x[10] + x[13]*x[19] - x[13]*x[20] + 400
+	h[18] = x[8] - x[11] - 4.60517*x[14] + x[14]*x[21] + 100
+}
@@ -0,0 +1,406 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// UF returns the k-th unconstrained problem of the CEC 2009 competition
+//  k -- index of problem: 1 ≤ k ≤ 10. UF1-7 have two objectives and UF8-10 have three
+//  n -- number of floats. 0 means 30
+//  Note: the functions are ported from the C++ code of the competition (cec09.cpp)
+//  Reference: Zhang Q, Zhou A, Zhao S, Suganthan PN, Liu W, Tiwari S (2009) Multiobjective
+//             optimization test instances for the CEC 2009 special session and competition.
+//             Technical Report CES-487, University of Essex
+func UF(k, n int) (o *Problem) {
+	if k < 1 || k > 10 {
+		chk.Panic("index of UF problem must be in [1,10]. k=%d is invalid", k)
+	}
+	if n == 0 {
+		n = 30
+	}
+	o = &Problem{Name: io.Sf("UF%d", k), Nf: 2}
+	if k > 7 {
+		o.Nf = 3
+	}
+	if n < 2*o.Nf-1 {
+		chk.Panic("%s requires at least %d variables. n=%d is invalid", o.Name, 2*o.Nf-1, n)
+	}
+	switch k {
+	case 3:
+		o.Xmin, o.Xmax = bounds(n, n, 0, 1)
+	case 4:
+		o.Xmin, o.Xmax = bounds(n, 1, -2, 2)
+	case 8, 9, 10:
+		o.Xmin, o.Xmax = bounds(n, 2, -2, 2)
+	default:
+		o.Xmin, o.Xmax = bounds(n, 1, -1, 1)
+	}
+	nx := float64(n)
+	π := math.Pi
+
+	// two objectives
+	if o.Nf == 2 {
+		o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+			var sum, prod, count [2]float64
+			prod[0], prod[1] = 1, 1
+			for j := 2; j <= n; j++ {
+				J := float64(j)
+				e := (j + 1) % 2 // 0: odd; 1: even
+				var y float64
+				switch k {
+				case 2:
+					c := 0.3 * x[0] * (x[0]*math.Cos(24*π*x[0]+4*J*π/nx) + 2)
+					if e == 1 {
+						y = x[j-1] - c*math.Sin(6*π*x[0]+J*π/nx)
+					} else {
+						y = x[j-1] - c*math.Cos(6*π*x[0]+J*π/nx)
+					}
+				case 3:
+					y = x[j-1] - math.Pow(x[0], 0.5*(1+3*(J-2)/(nx-2)))
+				default:
+					y = x[j-1] - math.Sin(6*π*x[0]+J*π/nx)
+				}
+				switch k {
+				case 3, 6:
+					sum[e] += y * y
+					prod[e] *= math.Cos(20 * y * π / math.Sqrt(J))
+				case 4:
+					sum[e] += math.Abs(y) / (1 + math.Exp(2*math.Abs(y)))
+				case 5:
+					sum[e] += 2*y*y - math.Cos(4*π*y) + 1
+				default:
+					sum[e] += y * y
+				}
+				count[e]++
+			}
+			var d [2]float64
+			for e := 0; e < 2; e++ {
+				if k == 3 || k == 6 {
+					d[e] = 2 * (4*sum[e] - 2*prod[e] + 2) / count[e]
+				} else {
+					d[e] = 2 * sum[e] / count[e]
+				}
+			}
+			switch k {
+			case 4:
+				f[0], f[1] = x[0], 1-x[0]*x[0]
+			case 5:
+				c := (0.5/10 + 0.1) * math.Abs(math.Sin(2*10*π*x[0]))
+				f[0], f[1] = x[0]+c, 1-x[0]+c
+			case 6:
+				c := math.Max(0, 2*(0.5/2+0.1)*math.Sin(2*2*π*x[0]))
+				f[0], f[1] = x[0]+c, 1-x[0]+c
+			case 7:
+				c := math.Pow(x[0], 0.2)
+				f[0], f[1] = c, 1-c
+			default:
+				f[0], f[1] = x[0], 1-math.Sqrt(x[0])
+			}
+			f[0] += d[0]
+			f[1] += d[1]
+		}
+		switch k {
+		case 4:
+			o.Front = func(npts int) [][]float64 {
+				return curve(npts, [][]float64{{0, 1}}, func(t float64) float64 { return 1 - t*t })
+			}
+		case 5:
+			o.Front = func(npts int) [][]float64 {
+				return curve(npts, points(0, 1, 21), func(t float64) float64 { return 1 - t })
+			}
+		case 6:
+			o.Front = func(npts int) [][]float64 {
+				return curve(npts, [][]float64{{0, 0}, {0.25, 0.5}, {0.75, 1}}, func(t float64) float64 { return 1 - t })
+			}
+		case 7:
+			o.Front = func(npts int) [][]float64 {
+				return curve(npts, [][]float64{{0, 1}}, func(t float64) float64 { return 1 - t })
+			}
+		default:
+			o.Front = func(npts int) [][]float64 {
+				return curve(npts, [][]float64{{0, 1}}, func(t float64) float64 { return 1 - math.Sqrt(t) })
+			}
+		}
+		return
+	}
+
+	// three objectives
+	o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+		d := ufDist(x, k == 10)
+		if k == 9 {
+			c := math.Max(0, 1.1*(1-4*(2*x[0]-1)*(2*x[0]-1)))
+			f[0] = 0.5*(c+2*x[0])*x[1] + d[0]
+			f[1] = 0.5*(c-2*x[0]+2)*x[1] + d[1]
+			f[2] = 1 - x[1] + d[2]
+			return
+		}
+		ufSphere(f, x, d)
+	}
+	if k == 9 {
+		o.Front = func(npts int) [][]float64 {
+			return surface(npts, [][]float64{{0, 0.25}, {0.75, 1}}, func(u, v float64) []float64 {
+				return []float64{(1 - u) * v, (1 - u) * (1 - v), u}
+			})
+		}
+		return
+	}
+	o.Front = func(npts int) [][]float64 {
+		return surface(npts, [][]float64{{0, 1}}, sphere)
+	}
+	return
+}
+
+// CF returns the k-th constrained problem of the CEC 2009 competition
+//  k -- index of problem: 1 ≤ k ≤ 10. CF1-7 have two objectives and CF8-10 have three
+//  n -- number of floats. 0 means 10
+//  Note: the functions are ported from the C++ code of the competition (cec09.cpp). The
+//        constraints c ≥ 0 of the competition are the constraints g ≥ 0 of goga
+//  Reference: see UF
+func CF(k, n int) (o *Problem) {
+	if k < 1 || k > 10 {
+		chk.Panic("index of CF problem must be in [1,10]. k=%d is invalid", k)
+	}
+	if n == 0 {
+		n = 10
+	}
+	o = &Problem{Name: io.Sf("CF%d", k), Nf: 2, Ng: 1}
+	switch {
+	case k > 7:
+		o.Nf = 3
+	case k == 6 || k == 7:
+		o.Ng = 2
+	}
+	nmin := 2*o.Nf - 1
+	if o.Ng == 2 {
+		nmin = 4
+	}
+	if n < nmin {
+		chk.Panic("%s requires at least %d variables. n=%d is invalid", o.Name, nmin, n)
+	}
+	switch k {
+	case 1:
+		o.Xmin, o.Xmax = bounds(n, n, 0, 1)
+	case 2:
+		o.Xmin, o.Xmax = bounds(n, 1, -1, 1)
+	case 8:
+		o.Xmin, o.Xmax = bounds(n, 2, -4, 4)
+	case 9, 10:
+		o.Xmin, o.Xmax = bounds(n, 2, -2, 2)
+	default:
+		o.Xmin, o.Xmax = bounds(n, 1, -2, 2)
+	}
+	nx := float64(n)
+	π := math.Pi
+	sig := func(t float64) float64 { return t / (1 + math.Exp(4*math.Abs(t))) }
+	sgnsqrt := func(t float64) float64 {
+		if t > 0 {
+			return math.Sqrt(t)
+		}
+		return -math.Sqrt(-t)
+	}
+
+	// three objectives
+	if o.Nf == 3 {
+		o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+			ufSphere(f, x, ufDist(x, k == 10))
+			r := (f[0]*f[0] + f[1]*f[1]) / (1 - f[2]*f[2])
+			s := math.Sin(2 * π * ((f[0]*f[0]-f[1]*f[1])/(1-f[2]*f[2]) + 1))
+			switch k {
+			case 8:
+				g[0] = r - 4*math.Abs(s) - 1
+			case 9:
+				g[0] = r - 3*s - 1
+			default:
+				g[0] = r - s - 1
+			}
+		}
+		o.Front = func(npts int) [][]float64 {
+			ranges := [][]float64{{0, 0}, {0.25, 0.5}, {0.75, 1}}
+			if k == 8 {
+				ranges = points(0, 1, 5)
+			}
+			return surface(npts, ranges, func(u, v float64) []float64 {
+				f2 := math.Sin(u * π / 2)
+				return []float64{math.Sqrt((1 - f2*f2) * v), math.Sqrt((1 - f2*f2) * (1 - v)), f2}
+			})
+		}
+		return
+	}
+
+	// two objectives
+	o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+		var sum, prod, count [2]float64
+		prod[0], prod[1] = 1, 1
+		for j := 2; j <= n; j++ {
+			J := float64(j)
+			e := (j + 1) % 2 // 0: odd; 1: even
+			var y float64
+			switch k {
+			case 1:
+				y = x[j-1] - math.Pow(x[0], 0.5*(1+3*(J-2)/(nx-2)))
+			case 2:
+				if e == 0 {
+					y = x[j-1] - math.Sin(6*π*x[0]+J*π/nx)
+				} else {
+					y = x[j-1] - math.Cos(6*π*x[0]+J*π/nx)
+				}
+			case 7:
+				if e == 0 {
+					y = x[j-1] - math.Cos(6*π*x[0]+J*π/nx)
+				} else {
+					y = x[j-1] - math.Sin(6*π*x[0]+J*π/nx)
+				}
+			case 5, 6:
+				if e == 0 {
+					y = x[j-1] - 0.8*x[0]*math.Cos(6*π*x[0]+J*π/nx)
+				} else {
+					y = x[j-1] - 0.8*x[0]*math.Sin(6*π*x[0]+J*π/nx)
+				}
+			default:
+				y = x[j-1] - math.Sin(6*π*x[0]+J*π/nx)
+			}
+			switch {
+			case k == 3:
+				sum[e] += y * y
+				prod[e] *= math.Cos(20 * y * π / math.Sqrt(J))
+			case (k == 4 || k == 5) && j == 2:
+				if y < 1.5-0.75*math.Sqrt(2) {
+					sum[e] += math.Abs(y)
+				} else {
+					sum[e] += 0.125 + (y-1)*(y-1)
+				}
+			case k == 5 || (k == 7 && j != 2 && j != 4):
+				sum[e] += 2*y*y - math.Cos(4*π*y) + 1
+			default:
+				sum[e] += y * y
+			}
+			count[e]++
+		}
+		var d [2]float64
+		for e := 0; e < 2; e++ {
+			switch k {
+			case 3:
+				d[e] = 2 * (4*sum[e] - 2*prod[e] + 2) / count[e]
+			case 1, 2:
+				d[e] = 2 * sum[e] / count[e]
+			default:
+				d[e] = sum[e]
+			}
+		}
+		switch k {
+		case 1:
+			f[0], f[1] = x[0]+d[0], 1-x[0]+d[1]
+			g[0] = f[1] + f[0] - math.Abs(math.Sin(10*π*(f[0]-f[1]+1))) - 1
+		case 2:
+			f[0], f[1] = x[0]+d[0], 1-math.Sqrt(x[0])+d[1]
+			g[0] = sig(f[1] + math.Sqrt(f[0]) - math.Sin(2*π*(math.Sqrt(f[0])-f[1]+1)) - 1)
+		case 3:
+			f[0], f[1] = x[0]+d[0], 1-x[0]*x[0]+d[1]
+			g[0] = f[1] + f[0]*f[0] - math.Sin(2*π*(f[0]*f[0]-f[1]+1)) - 1
+		case 4:
+			f[0], f[1] = x[0]+d[0], 1-x[0]+d[1]
+			g[0] = sig(x[1] - math.Sin(6*x[0]*π+2*π/nx) - 0.5*x[0] + 0.25)
+		case 5:
+			f[0], f[1] = x[0]+d[0], 1-x[0]+d[1]
+			g[0] = x[1] - 0.8*x[0]*math.Sin(6*x[0]*π+2*π/nx) - 0.5*x[0] + 0.25
+		default:
+			a := 1.0
+			if k == 6 {
+				a = 0.8 * x[0]
+			}
+			f[0], f[1] = x[0]+d[0], (1-x[0])*(1-x[0])+d[1]
+			g[0] = x[1] - a*math.Sin(6*x[0]*π+2*π/nx) - sgnsqrt((x[0]-0.5)*(1-x[0]))
+			g[1] = x[3] - a*math.Sin(6*x[0]*π+4*π/nx) - sgnsqrt(0.25*math.Sqrt(1-x[0])-0.5*(1-x[0]))
+		}
+	}
+	switch k {
+	case 1:
+		o.Front = func(npts int) [][]float64 {
+			return curve(npts, points(0, 1, 21), func(t float64) float64 { return 1 - t })
+		}
+	case 2:
+		o.Front = func(npts int) [][]float64 {
+			return curve(npts, [][]float64{{0, 0}, {1.0 / 16.0, 0.25}, {9.0 / 16.0, 1}}, func(t float64) float64 { return 1 - math.Sqrt(t) })
+		}
+	case 3:
+		o.Front = func(npts int) [][]float64 {
+			return curve(npts, [][]float64{{0, 0}, {0.5, math.Sqrt(0.5)}, {math.Sqrt(0.75), 1}}, func(t float64) float64 { return 1 - t*t })
+		}
+	case 4, 5:
+		o.Front = func(npts int) [][]float64 {
+			return curve(npts, [][]float64{{0, 0.5}, {0.5, 0.75}, {0.75, 1}}, func(t float64) float64 {
+				switch {
+				case t <= 0.5:
+					return 1 - t
+				case t <= 0.75:
+					return -0.5*t + 0.75
+				}
+				return 1 - t + 0.125
+			})
+		}
+	default:
+		o.Front = func(npts int) [][]float64 {
+			return curve(npts, [][]float64{{0, 0.5}, {0.5, 0.75}, {0.75, 1}}, func(t float64) float64 {
+				switch {
+				case t <= 0.5:
+					return (1 - t) * (1 - t)
+				case t <= 0.75:
+					return 0.5 * (1 - t)
+				}
+				return 0.25 * math.Sqrt(1-t)
+			})
+		}
+	}
+	return
+}
+
+// ufDist computes the distance terms of UF8-10 and CF8-10
+//  rastrigin -- use the multi-modal distance function of UF10 and CF10
+func ufDist(x []float64, rastrigin bool) (d [3]float64) {
+	n := len(x)
+	var count [3]float64
+	for j := 3; j <= n; j++ {
+		y := x[j-1] - 2*x[1]*math.Sin(2*math.Pi*x[0]+float64(j)*math.Pi/float64(n))
+		e := (j + 2) % 3 // j%3 == 1 → 0; j%3 == 2 → 1; j%3 == 0 → 2
+		if rastrigin {
+			d[e] += 4*y*y - math.Cos(8*math.Pi*y) + 1
+		} else {
+			d[e] += y * y
+		}
+		count[e]++
+	}
+	for e := 0; e < 3; e++ {
+		d[e] = 2 * d[e] / count[e]
+	}
+	return
+}
+
+// ufSphere computes the objectives of problems with spherical fronts (UF8, UF10 and CF8-10)
+func ufSphere(f, x []float64, d [3]float64) {
+	f[0] = math.Cos(0.5*math.Pi*x[0])*math.Cos(0.5*math.Pi*x[1]) + d[0]
+	f[1] = math.Cos(0.5*math.Pi*x[0])*math.Sin(0.5*math.Pi*x[1]) + d[1]
+	f[2] = math.Sin(0.5*math.Pi*x[0]) + d[2]
+}
+
+// sphere returns a point on the unit sphere (first octant) given u, v ∈ [0,1]
+func sphere(u, v float64) []float64 {
+	f2 := math.Sin(u * math.Pi / 2)
+	r := math.Cos(u * math.Pi / 2)
+	return []float64{r * math.Cos(v*math.Pi/2), r * math.Sin(v*math.Pi/2), f2}
+}
+
+// points returns n isolated points in [a,b] as degenerate intervals
+func points(a, b float64, n int) (P [][]float64) {
+	for i := 0; i < n; i++ {
+		t := a + (b-a)*float64(i)/float64(n-1)
+		P = append(P, []float64{t, t})
+	}
+	return
+}
@@ -0,0 +1,145 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// DTLZ returns the k-th scalable problem of Deb, Thiele, Laumanns and Zitzler (2005)
+//  k  -- index of problem: 1 ≤ k ≤ 7
+//  nf -- number of objectives (M ≥ 2)
+//  n  -- number of floats. 0 means M-1+K with K = 5 (DTLZ1), 10 (DTLZ2-6) or 20 (DTLZ7)
+//  Reference: Deb K, Thiele L, Laumanns M, Zitzler E (2005) Scalable test problems for
+//             evolutionary multiobjective optimization. In: Evolutionary Multiobjective
+//             Optimization, Springer, 105-145
+func DTLZ(k, nf, n int) (o *Problem) {
+	if k < 1 || k > 7 {
+		chk.Panic("index of DTLZ problem must be in [1,7]. k=%d is invalid", k)
+	}
+	if nf < 2 {
+		chk.Panic("DTLZ problems require at least 2 objectives. nf=%d is invalid", nf)
+	}
+	if n == 0 {
+		switch k {
+		case 1:
+			n = nf - 1 + 5
+		case 7:
+			n = nf - 1 + 20
+		default:
+			n = nf - 1 + 10
+		}
+	}
+	if n < nf {
+		chk.Panic("DTLZ problems require at least nf=%d variables. n=%d is invalid", nf, n)
+	}
+	o = &Problem{Name: io.Sf("DTLZ%d", k), Nf: nf}
+	o.Xmin, o.Xmax = bounds(n, n, 0, 1)
+	m := nf - 1 // number of position variables
+	o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+
+		// distance function
+		c := 0.0
+		switch k {
+		case 1, 3:
+			for _, v := range x[m:] {
+				c += (v-0.5)*(v-0.5) - math.Cos(20*math.Pi*(v-0.5))
+			}
+			c = 100 * (float64(n-m) + c)
+		case 6:
+			for _, v := range x[m:] {
+				c += math.Pow(v, 0.1)
+			}
+		case 7:
+			for _, v := range x[m:] {
+				c += v
+			}
+			c = 1 + 9*c/float64(n-m)
+		default:
+			for _, v := range x[m:] {
+				c += (v - 0.5) * (v - 0.5)
+			}
+		}
+
+		// linear front
+		if k == 1 {
+			for i := 0; i < nf; i++ {
+				f[i] = 0.5 * (1 + c)
+				for j := 0; j < m-i; j++ {
+					f[i] *= x[j]
+				}
+				if i > 0 {
+					f[i] *= 1 - x[m-i]
+				}
+			}
+			return
+		}
+
+		// disconnected front
+		if k == 7 {
+			sum := 0.0
+			for i := 0; i < m; i++ {
+				f[i] = x[i]
+				sum += f[i] / c * (1 + math.Sin(3*math.Pi*f[i]))
+			}
+			f[m] = c * (float64(nf) - sum)
+			return
+		}
+
+		// spherical fronts
+		θ := make([]float64, m)
+		for j := 0; j < m; j++ {
+			switch {
+			case k == 4:
+				θ[j] = math.Pow(x[j], 100) * math.Pi / 2
+			case (k == 5 || k == 6) && j > 0:
+				θ[j] = math.Pi / (4 * (1 + c)) * (1 + 2*c*x[j])
+			default:
+				θ[j] = x[j] * math.Pi / 2
+			}
+		}
+		for i := 0; i < nf; i++ {
+			f[i] = 1 + c
+			for j := 0; j < m-i; j++ {
+				f[i] *= math.Cos(θ[j])
+			}
+			if i > 0 {
+				f[i] *= math.Sin(θ[m-i])
+			}
+		}
+	}
+
+	// Pareto optimal set: distance variables at their optimum
+	xopt := 0.5
+	switch k {
+	case 6, 7:
+		xopt = 0
+	}
+	npos := m
+	if k == 5 || k == 6 {
+		npos = 1 // degenerate (curve) front
+	}
+	o.Front = func(npts int) [][]float64 {
+		return frontFromSet(o.Fcn, nf, n, npos, npts, func(x, p []float64) {
+			for j := 0; j < m; j++ {
+				switch {
+				case j >= npos:
+					x[j] = 0.5
+				case k == 4:
+					x[j] = math.Pow(p[j], 0.01)
+				default:
+					x[j] = p[j]
+				}
+			}
+			for j := m; j < n; j++ {
+				x[j] = xopt
+			}
+		})
+	}
+	return
+}
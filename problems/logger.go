@@ -0,0 +1,157 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Record holds the number of function evaluations needed to reach a precision Df = fbest - fopt
+type Record struct {
+	Nfeval int     // number of function evaluations
+	Df     float64 // precision: best objective value so far minus optimal value
+}
+
+// Logger records the runtimes (number of function evaluations) of single-objective runs to reach
+// target precisions, as in the BBOB/COCO experiments
+//  Note: Wrap the objective function of a problem and use the wrapped function with the
+//        optimiser. Constraints are not considered
+type Logger struct {
+	Fopt    float64   // optimal objective value
+	Targets []float64 // target precisions in decreasing order
+	Nfeval  int       // number of function evaluations
+	Fbest   float64   // best objective value so far
+	Records []Record  // records of the first evaluation reaching each new target
+
+	mutex   sync.Mutex // to wrap functions called concurrently
+	itarget int        // index of next target to be reached
+}
+
+// DefaultTargets returns the target precisions 10^2, 10^1.8, ..., 10^-8 of BBOB
+func DefaultTargets() (targets []float64) {
+	targets = make([]float64, 51)
+	for i := 0; i < 51; i++ {
+		targets[i] = math.Pow(10, 2-float64(i)/5)
+	}
+	return
+}
+
+// NewLogger returns a new Logger
+//  fopt    -- optimal objective value; e.g. prob.Fref[0]
+//  targets -- target precisions in decreasing order. nil means DefaultTargets
+func NewLogger(fopt float64, targets []float64) (o *Logger) {
+	if targets == nil {
+		targets = DefaultTargets()
+	}
+	for i := 1; i < len(targets); i++ {
+		if targets[i] >= targets[i-1] {
+			chk.Panic("targets of Logger must be in decreasing order. %g ≥ %g is invalid", targets[i], targets[i-1])
+		}
+	}
+	return &Logger{Fopt: fopt, Targets: targets, Fbest: math.Inf(1)}
+}
+
+// Wrap returns a function that calls fcn and records the runtimes to reach the targets
+func (o *Logger) Wrap(fcn goga.MinProb_t) goga.MinProb_t {
+	return func(f, g, h, x []float64, ξ []int, cpu int) {
+		fcn(f, g, h, x, ξ, cpu)
+		o.mutex.Lock()
+		defer o.mutex.Unlock()
+		o.Nfeval++
+		if f[0] >= o.Fbest {
+			return
+		}
+		o.Fbest = f[0]
+		df := o.Fbest - o.Fopt
+		reached := false
+		for o.itarget < len(o.Targets) && df <= o.Targets[o.itarget] {
+			o.itarget++
+			reached = true
+		}
+		if reached {
+			o.Records = append(o.Records, Record{o.Nfeval, df})
+		}
+	}
+}
+
+// Runtime returns the number of function evaluations needed to reach the target precision
+//  ok -- false if the target has not been reached
+func (o *Logger) Runtime(target float64) (nfeval int, ok bool) {
+	for _, r := range o.Records {
+		if r.Df <= target {
+			return r.Nfeval, true
+		}
+	}
+	return
+}
+
+// Write writes the records to file with the columns "nfeval" and "df"
+func (o *Logger) Write(dirout, fnkey string) {
+	var buf bytes.Buffer
+	io.Ff(&buf, "%8s %23s\n", "nfeval", "df")
+	for _, r := range o.Records {
+		io.Ff(&buf, "%8d %23.15e\n", r.Nfeval, r.Df)
+	}
+	io.WriteFileVD(dirout, fnkey+".dat", &buf)
+}
+
+// ECDF computes the empirical cumulative distribution function of runtimes; i.e. the fraction of
+// (run, target) pairs with targets reached within each budget
+//  loggers -- one logger per run; e.g. of many functions or instances
+//  targets -- target precisions. nil means DefaultTargets
+//  budgets -- numbers of function evaluations in increasing order
+func ECDF(loggers []*Logger, targets []float64, budgets []int) (res []float64) {
+	if targets == nil {
+		targets = DefaultTargets()
+	}
+	var runtimes []int
+	for _, l := range loggers {
+		for _, t := range targets {
+			if n, ok := l.Runtime(t); ok {
+				runtimes = append(runtimes, n)
+			}
+		}
+	}
+	sort.Ints(runtimes)
+	npairs := float64(len(loggers) * len(targets))
+	res = make([]float64, len(budgets))
+	for i, b := range budgets {
+		res[i] = float64(sort.SearchInts(runtimes, b+1)) / npairs
+	}
+	return
+}
+
+// PlotECDF plots the ECDF of runtimes divided by the dimension in log10 scale
+//  dim  -- dimension of problems
+//  args -- arguments for plot; e.g. "'b-', label='DE'"
+//  Note: the budgets are logarithmically spaced up to the largest number of evaluations
+func PlotECDF(loggers []*Logger, targets []float64, dim int, args string) {
+	nmax := 1
+	for _, l := range loggers {
+		nmax = utl.Imax(nmax, l.Nfeval)
+	}
+	var budgets []int
+	for _, v := range utl.LinSpace(0, math.Log10(float64(nmax)), 101) {
+		b := int(math.Pow(10, v) + 0.5)
+		if len(budgets) == 0 || b > budgets[len(budgets)-1] {
+			budgets = append(budgets, b)
+		}
+	}
+	x := make([]float64, len(budgets))
+	for i, b := range budgets {
+		x[i] = math.Log10(float64(b) / float64(dim))
+	}
+	plt.Plot(x, ECDF(loggers, targets, budgets), "drawstyle='steps-post', "+args)
+	plt.Gll("$\\log_{10}(n_{feval}/D)$", "fraction of (run, target) pairs", "")
+}
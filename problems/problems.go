@@ -0,0 +1,254 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// package problems implements benchmark problems for single- and multi-objective optimisation
+//  Suites: ZDT1-6, DTLZ1-7, WFG1-9, CEC09 (UF1-10 and CF1-10), CEC06 (G01-G24)
+//          and BBOB (BBOB1-24)
+package problems
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+// Problem holds the definition of a benchmark problem
+//  Note: constraints follow the goga convention: g ≥ 0 and h = 0
+type Problem struct {
+	Name   string                     // name of problem; e.g. "ZDT1", "DTLZ2", "WFG4", "UF1", "CF1" or "G01"
+	Nf     int                        // number of objective functions
+	Ng     int                        // number of inequality constraints
+	Nh     int                        // number of equality constraints
+	Xmin   []float64                  // lower bounds of floats
+	Xmax   []float64                  // upper bounds of floats
+	BinInt int                        // number of bits of binary problems (ZDT5). Xmin and Xmax are nil
+	Fcn    goga.MinProb_t             // objective and constraint functions
+	Front  func(npts int) [][]float64 // samples approximately npts points [npts][Nf] on the Pareto front
+	Xref   []float64                  // best known solution of single-objective problems; may be nil
+	Fref   []float64                  // best known objective values of single-objective problems; may be nil
+	EpsH   float64                    // tolerance of equality constraints. 0 means the default of the optimiser
+}
+
+// Init initialises optimiser to solve this problem
+//  Note: other parameters such as Nsol, Tf or Ncpu may be set before calling Init. The reference
+//        values for reports (RptXref and RptFref) are set if not given yet
+func (o *Problem) Init(opt *goga.Optimiser) {
+	opt.FltMin = append([]float64(nil), o.Xmin...)
+	opt.FltMax = append([]float64(nil), o.Xmax...)
+	opt.BinInt = o.BinInt
+	if opt.RptName == "" {
+		opt.RptName = o.Name
+	}
+	if len(opt.RptXref) == 0 {
+		opt.RptXref = append([]float64(nil), o.Xref...)
+	}
+	if len(opt.RptFref) == 0 {
+		opt.RptFref = append([]float64(nil), o.Fref...)
+	}
+	if o.EpsH > 0 {
+		opt.EpsH = o.EpsH
+	}
+	opt.Init(goga.GenTrialSolutions, nil, o.Fcn, o.Nf, o.Ng, o.Nh)
+}
+
+// New returns a benchmark problem by name
+//  name -- e.g. "ZDT1", "DTLZ2", "WFG4", "UF1", "CF1", "G01" or "BBOB1" (case insensitive)
+//  nf   -- number of objectives of scalable problems (DTLZ and WFG). 0 means 3 (DTLZ) or 2 (WFG)
+//          with k = 2(nf-1) position parameters. CEC06 problems (G01-G24) have fixed dimensions
+//  nx   -- number of floats. 0 means the default of each suite; e.g. 10 for BBOB functions,
+//          which are created with instance 1
+func New(name string, nf, nx int) (prob *Problem, err error) {
+	res := regexp.MustCompile(`^([A-Z]+)([0-9]+)$`).FindStringSubmatch(strings.ToUpper(name))
+	if res == nil {
+		return nil, chk.Err("name of benchmark problem %q is invalid", name)
+	}
+	idx, _ := strconv.Atoi(res[2])
+	defer func() {
+		if r := recover(); r != nil {
+			prob, err = nil, chk.Err("cannot create benchmark problem %q:\n%v", name, r)
+		}
+	}()
+	switch res[1] {
+	case "ZDT":
+		return ZDT(idx, nx), nil
+	case "DTLZ":
+		if nf == 0 {
+			nf = 3
+		}
+		return DTLZ(idx, nf, nx), nil
+	case "WFG":
+		if nf == 0 {
+			nf = 2
+		}
+		k, l := 2*(nf-1), 20
+		if nx > 0 {
+			l = nx - k
+		}
+		return WFG(idx, nf, k, l), nil
+	case "UF":
+		return UF(idx, nx), nil
+	case "CF":
+		return CF(idx, nx), nil
+	case "G":
+		return CEC06(idx), nil
+	case "BBOB":
+		if nx == 0 {
+			nx = 10
+		}
+		return BBOB(idx, nx, 1), nil
+	}
+	return nil, chk.Err("benchmark problem %q is not available. suites are ZDT, DTLZ, WFG, UF, CF, G and BBOB", name)
+}
+
+// Names returns the names of all problems with fixed number of objectives and the names of
+// scalable problems (DTLZ and WFG)
+func Names() (names []string) {
+	for _, suite := range []struct {
+		key string
+		n   int
+	}{{"ZDT%d", 6}, {"DTLZ%d", 7}, {"WFG%d", 9}, {"UF%d", 10}, {"CF%d", 10}, {"G%02d", 24}, {"BBOB%d", 24}} {
+		for i := 1; i <= suite.n; i++ {
+			names = append(names, io.Sf(suite.key, i))
+		}
+	}
+	return
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// bounds returns bounds of n floats where the first nfirst floats are in [0,1] and the others in
+// [lo,hi]
+func bounds(n, nfirst int, lo, hi float64) (xmin, xmax []float64) {
+	xmin, xmax = make([]float64, n), make([]float64, n)
+	for i := 0; i < n; i++ {
+		if i < nfirst {
+			xmin[i], xmax[i] = 0, 1
+		} else {
+			xmin[i], xmax[i] = lo, hi
+		}
+	}
+	return
+}
+
+// samplePositions returns approximately npts points [npts][m] in [0,1]^m
+//  Note: uniform grids are used if m ≤ 2; otherwise, Sobol points are used
+func samplePositions(m, npts int) (P [][]float64) {
+	switch m {
+	case 1:
+		for _, v := range utl.LinSpace(0, 1, npts) {
+			P = append(P, []float64{v})
+		}
+	case 2:
+		n := int(math.Ceil(math.Sqrt(float64(npts))))
+		for _, u := range utl.LinSpace(0, 1, n) {
+			for _, v := range utl.LinSpace(0, 1, n) {
+				P = append(P, []float64{u, v})
+			}
+		}
+	default:
+		X := goga.SobolPoints(m, npts, false)
+		P = make([][]float64, npts)
+		for i := 0; i < npts; i++ {
+			P[i] = make([]float64, m)
+			for j := 0; j < m; j++ {
+				P[i][j] = X[j][i]
+			}
+		}
+	}
+	return
+}
+
+// frontFromSet samples the Pareto front by evaluating Pareto optimal solutions
+//  m      -- number of position variables
+//  optSol -- computes a Pareto optimal solution x from the position variables p ∈ [0,1]^m
+//  Note: dominated points are removed; e.g. of disconnected fronts
+func frontFromSet(fcn goga.MinProb_t, nf, nx, m, npts int, optSol func(x, p []float64)) (F [][]float64) {
+	x := make([]float64, nx)
+	for _, p := range samplePositions(m, npts) {
+		optSol(x, p)
+		f := make([]float64, nf)
+		fcn(f, nil, nil, x, nil, 0)
+		F = append(F, f)
+	}
+	return nonDominated(F)
+}
+
+// nonDominated returns the non-dominated points of F
+func nonDominated(F [][]float64) (res [][]float64) {
+	if len(F) == 0 {
+		return
+	}
+	sorted := make([][]float64, len(F))
+	copy(sorted, F)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i][0] == sorted[j][0] {
+			return sorted[i][1] < sorted[j][1]
+		}
+		return sorted[i][0] < sorted[j][0]
+	})
+
+	// two objectives: sweep
+	if len(F[0]) == 2 {
+		best := math.Inf(1)
+		for _, f := range sorted {
+			if f[1] < best {
+				res = append(res, f)
+				best = f[1]
+			}
+		}
+		return
+	}
+
+	// more objectives
+	for _, i := range utl.ParetoFront(sorted) {
+		res = append(res, sorted[i])
+	}
+	return
+}
+
+// union returns approximately n values in the union of intervals [a,b] distributed according to
+// the lengths of the intervals
+//  Note: intervals with a == b add a single value
+func union(n int, intervals [][]float64) (T []float64) {
+	length := 0.0
+	for _, r := range intervals {
+		length += r[1] - r[0]
+	}
+	for _, r := range intervals {
+		if r[1] > r[0] {
+			T = append(T, utl.LinSpace(r[0], r[1], utl.Imax(2, int(float64(n)*(r[1]-r[0])/length)))...)
+		} else {
+			T = append(T, r[0])
+		}
+	}
+	return
+}
+
+// curve samples approximately npts points (t, y(t)) of a two-objective front with t in the union
+// of intervals
+func curve(npts int, intervals [][]float64, y func(t float64) float64) (F [][]float64) {
+	for _, t := range union(npts, intervals) {
+		F = append(F, []float64{t, y(t)})
+	}
+	return
+}
+
+// surface samples approximately npts points of a three-objective front with u ∈ [0,1] and v in
+// the union of intervals
+func surface(npts int, intervals [][]float64, fcn func(u, v float64) []float64) (F [][]float64) {
+	V := union(int(math.Ceil(math.Sqrt(float64(npts)))), intervals)
+	for _, u := range utl.LinSpace(0, 1, utl.Imax(2, npts/len(V))) {
+		for _, v := range V {
+			F = append(F, fcn(u, v))
+		}
+	}
+	return
+}
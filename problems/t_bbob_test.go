@@ -0,0 +1,291 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_bbob01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("bbob01. optimal values and solutions of BBOB functions")
+
+	// optimal values of instance 1 (BBOB 2009)
+	fopt := []float64{79.48, -209.88, -462.09, -462.09, -9.21, 35.9, 92.94, 149.15, 123.83, -54.94,
+		76.27, -621.11, 29.97, -52.35, 1000, 71.35, -16.94, -16.94, -102.55, -546.5, 40.78, -1000, 6.87, 102.61}
+	f := make([]float64, 1)
+	for _, dim := range []int{2, 5, 10} {
+		for k := 1; k <= 24; k++ {
+			prob := BBOB(k, dim, 1)
+			chk.Scalar(tst, io.Sf("BBOB%d: fopt", k), 1e-15, prob.Fref[0], fopt[k-1])
+			chk.IntAssert(len(prob.Xref), dim)
+			for _, x := range prob.Xref {
+				if x < -5 || x > 5 {
+					tst.Errorf("BBOB%d: optimal solution is out of bounds. x=%g\n", k, x)
+					return
+				}
+			}
+			prob.Fcn(f, nil, nil, prob.Xref, nil, 0)
+			tol := 1e-12
+			if k == 20 { // approximate optimum
+				tol = 1e-2
+			}
+			chk.Scalar(tst, io.Sf("BBOB%d: f(xopt) (dim=%d)", k, dim), tol, f[0], fopt[k-1])
+		}
+	}
+
+	// f(x) > f(xopt) at trial points
+	for k := 1; k <= 24; k++ {
+		prob := BBOB(k, 5, 3)
+		prob.Fcn(f, nil, nil, trial(prob.Xmin, prob.Xmax), nil, 0)
+		if f[0] <= prob.Fref[0] {
+			tst.Errorf("BBOB%d: f(x)=%g should be greater than fopt=%g\n", k, f[0], prob.Fref[0])
+		}
+	}
+}
+
+func Test_bbob02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("bbob02. rotation matrices and random numbers")
+
+	for _, dim := range []int{2, 3, 10} {
+		R := bbobRotation(10001, dim)
+		for i := 0; i < dim; i++ {
+			for j := 0; j < dim; j++ {
+				prod := 0.0
+				for k := 0; k < dim; k++ {
+					prod += R[k][i] * R[k][j]
+				}
+				if i == j {
+					chk.Scalar(tst, "RᵀR: ii", 1e-14, prod, 1)
+				} else {
+					chk.Scalar(tst, "RᵀR: ij", 1e-14, prod, 0)
+				}
+			}
+		}
+	}
+
+	u := bbobUnif(1000, 12345)
+	mean := 0.0
+	for _, v := range u {
+		if v <= 0 || v >= 1 {
+			tst.Errorf("uniform random number must be in (0,1). %g is invalid\n", v)
+			return
+		}
+		mean += v / 1000
+	}
+	chk.Scalar(tst, "mean", 0.02, mean, 0.5)
+	chk.Vector(tst, "same seed", 1e-17, bbobUnif(10, 12345), u[:10])
+}
+
+func Test_bbob03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("bbob03. logger and ECDF")
+
+	// targets
+	targets := DefaultTargets()
+	chk.IntAssert(len(targets), 51)
+	chk.Scalar(tst, "first target", 1e-15, targets[0], 100)
+	chk.Scalar(tst, "last target", 1e-22, targets[50], 1e-8)
+
+	// records
+	l := NewLogger(1, []float64{10, 1, 0.1})
+	fcn := l.Wrap(func(f, g, h, x []float64, ξ []int, cpu int) { f[0] = x[0] })
+	f := make([]float64, 1)
+	for _, x := range []float64{100, 5, 8, 3, 1.05, 1.5, 1} {
+		fcn(f, nil, nil, []float64{x}, nil, 0)
+	}
+	chk.IntAssert(l.Nfeval, 7)
+	chk.Scalar(tst, "fbest", 1e-15, l.Fbest, 1)
+	chk.IntAssert(len(l.Records), 2)
+	chk.IntAssert(l.Records[0].Nfeval, 2)
+	chk.IntAssert(l.Records[1].Nfeval, 5)
+	chk.Scalar(tst, "df", 1e-15, l.Records[1].Df, 0.05)
+	n, ok := l.Runtime(1)
+	chk.IntAssert(n, 5)
+	if !ok {
+		tst.Errorf("target 1 should have been reached\n")
+	}
+	if _, ok = l.Runtime(1e-3); ok {
+		tst.Errorf("target 1e-3 should not have been reached\n")
+	}
+
+	// ECDF: runtimes are 2, 5 and 5 out of 3 targets
+	ecdf := ECDF([]*Logger{l}, []float64{10, 1, 1e-3}, []int{1, 2, 4, 5, 100})
+	chk.Vector(tst, "ecdf", 1e-15, ecdf, []float64{0, 1.0 / 3.0, 1.0 / 3.0, 2.0 / 3.0, 2.0 / 3.0})
+
+	// solving sphere function
+	var opt goga.Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Tf = 100
+	opt.Ncpu = 2
+	opt.Verbose = false
+	prob := BBOB(1, 5, 1)
+	l = NewLogger(prob.Fref[0], nil)
+	prob.Fcn = l.Wrap(prob.Fcn)
+	prob.Init(&opt)
+	opt.Solve()
+	io.Pforan("nfeval = %d (%d), fbest - fopt = %g\n", l.Nfeval, opt.Nfeval, l.Fbest-l.Fopt)
+	chk.IntAssert(l.Nfeval, opt.Nfeval)
+	if len(l.Records) == 0 || l.Fbest-l.Fopt > 1e-2 {
+		tst.Errorf("sphere function should have been solved. fbest - fopt = %g\n", l.Fbest-l.Fopt)
+	}
+}
+
+func Test_bbob04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("bbob04. transformations at non-optimal points")
+
+	// definitions of RR-6829 (independent of bbob.eval); i = 0...D-1
+	tosz := func(v float64) float64 {
+		if v == 0 {
+			return 0
+		}
+		c1, c2 := 10.0, 7.9
+		if v < 0 {
+			c1, c2 = 5.5, 3.1
+		}
+		xh := math.Log(math.Abs(v))
+		return math.Copysign(math.Exp(xh+0.049*(math.Sin(c1*xh)+math.Sin(c2*xh))), v)
+	}
+	tasy := func(z []float64, β float64) {
+		D := float64(len(z))
+		for i, v := range z {
+			if v > 0 {
+				z[i] = math.Pow(v, 1+β*float64(i)/(D-1)*math.Sqrt(v))
+			}
+		}
+	}
+	mul := func(M [][]float64, v []float64) []float64 {
+		res := make([]float64, len(v))
+		for i := range M {
+			for j := range v {
+				res[i] += M[i][j] * v[j]
+			}
+		}
+		return res
+	}
+	cond := func(α float64, i, dim int) float64 { // diagonal of Λ^α
+		return math.Pow(α, 0.5*float64(i)/float64(dim-1))
+	}
+	pen := func(x []float64) (res float64) {
+		for _, v := range x {
+			res += math.Pow(math.Max(0, math.Abs(v)-5), 2)
+		}
+		return
+	}
+	ref := func(b *bbob, x []float64) (f float64) {
+		D := b.dim
+		dx := make([]float64, D)
+		for i := range x {
+			dx[i] = x[i] - b.xopt[i]
+		}
+		switch b.fid {
+		case 1:
+			for _, v := range dx {
+				f += v * v
+			}
+		case 2:
+			for i, v := range dx {
+				f += math.Pow(1e6, float64(i)/float64(D-1)) * tosz(v) * tosz(v)
+			}
+		case 3:
+			z := make([]float64, D)
+			for i, v := range dx {
+				z[i] = tosz(v)
+			}
+			tasy(z, 0.2)
+			for i, v := range z {
+				v *= cond(10, i, D)
+				f += v*v - 10*math.Cos(2*math.Pi*v)
+			}
+			f += 10 * float64(D)
+		case 6:
+			y := mul(b.rot2, dx)
+			for i := range y {
+				y[i] *= cond(10, i, D)
+			}
+			for i, v := range mul(b.rot1, y) {
+				if v*b.xopt[i] > 0 {
+					v *= 100
+				}
+				f += v * v
+			}
+			f = math.Pow(tosz(f), 0.9)
+		case 7:
+			zh := mul(b.rot2, dx)
+			zt := make([]float64, D)
+			for i := range zh {
+				zh[i] *= cond(10, i, D)
+				if math.Abs(zh[i]) > 0.5 {
+					zt[i] = math.Floor(0.5 + zh[i])
+				} else {
+					zt[i] = math.Floor(0.5+10*zh[i]) / 10
+				}
+			}
+			for i, v := range mul(b.rot1, zt) {
+				f += math.Pow(100, float64(i)/float64(D-1)) * v * v
+			}
+			f = 0.1*math.Max(math.Abs(zh[0])/1e4, f) + pen(x)
+		case 10:
+			for i, v := range mul(b.rot1, dx) {
+				f += math.Pow(1e6, float64(i)/float64(D-1)) * tosz(v) * tosz(v)
+			}
+		case 13:
+			y := mul(b.rot2, dx)
+			for i := range y {
+				y[i] *= cond(10, i, D)
+			}
+			z := mul(b.rot1, y)
+			for _, v := range z[1:] {
+				f += v * v
+			}
+			f = z[0]*z[0] + 100*math.Sqrt(f)
+		case 15:
+			y := mul(b.rot1, dx)
+			for i, v := range y {
+				y[i] = tosz(v)
+			}
+			tasy(y, 0.2)
+			y = mul(b.rot2, y)
+			for i := range y {
+				y[i] *= cond(10, i, D)
+			}
+			for _, v := range mul(b.rot1, y) {
+				f += v*v - 10*math.Cos(2*math.Pi*v)
+			}
+			f += 10 * float64(D)
+		}
+		return f + b.fopt
+	}
+
+	// points around the optimum, including a point out of bounds
+	for _, k := range []int{1, 2, 3, 6, 7, 10, 13, 15} {
+		for _, dim := range []int{2, 5, 10} {
+			for _, instance := range []int{1, 2, 5} {
+				b := newBbob(k, dim, instance)
+				for _, δ := range []float64{0.03, -0.4, 1.7, 6} {
+					x := make([]float64, dim)
+					for i := range x {
+						x[i] = b.xopt[i] + δ*math.Sin(float64(3*i+1))
+					}
+					f := ref(b, x)
+					tol := 1e-12 * math.Max(1, math.Abs(f))
+					chk.Scalar(tst, io.Sf("BBOB%d: dim=%d instance=%d δ=%g", k, dim, instance, δ), tol, b.eval(x), f)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_cec06a(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cec06a. CEC 2006 problems")
+
+	// values computed with the C code of the CEC 2006 competition (f followed by -g and h)
+	for _, test := range []struct {
+		name string
+		ref  []float64
+	}{
+		{"G01", []float64{-100.65, -62.599999999999994, -21.8, -93.2, 2.4, -62, -25.2, 1.3, -68.9, -28}},
+		{"G02", []float64{-0.0924987852576714, -0.75, 47}},
+		{"G03", []float64{-0, 2.3600000000000003}},
+		{"G04", []float64{-26883.369198628003, -2.4190140559999946, 94.419014056, 1.0220095080000107, 18.97799049199999, 1.7715208920000016, 3.2284791079999984}},
+		{"G05", []float64{4678.656, 0.11000000000000004, 0.99, 262.44046069408273, -255.88421966773592, 110.23888098615839}},
+		{"G06", []float64{536642.171, 10087.81, -10037.800000000001}},
+		{"G07", []float64{1664, -7, -28, -50, -234, -148, -336, -306, -1342}},
+		{"G08", []float64{-2.768343883926083e-63, 0, -34}},
+		{"G09", []float64{2626699, -30091, 254, 124, -380}},
+		{"G10", []float64{19470, -0.7825, -1.005, 1.99, 1153350.1688400002, -1856250, 368900}},
+		{"G11", []float64{0.16000000000000003, 0.84}},
+		{"G12", []float64{-0.7, -0.9375}},
+		{"G13", []float64{777.7152448574313, 6.786000000000001, 26.048000000000002, 12.388311999999999}},
+		{"G14", []float64{-1017.2461890150311, 38, 25, 22}},
+		{"G15", []float64{707, 120, 150}},
+		{"G16", []float64{1.444660951727065, 169.82861519366142, 352.47, -24.409291432197897, -333.5338310000135, 198.23000000000005, -6.100000000000023, 21.454195941334046, 1014.7075040586659, 5.730295809096782, 18.02470419090322, 108.87262037283887, 342.48437962716116, 386.6700133178848, 190.33498668211513, 29.811080500179568, 235.14391949982044, 0.12296338833119358, 5.311036611668807, 0.041899549900545036, 0.034100450099454976, 143.95010902921797, 21.425890970782007, 83.75510266724154, 279.6568973327585, 245.1498682607529, 272.06413173924716, 11.043859815209224, 507.33114018479074, 1859.074269256571, 315.8017307434293, 18430.139616902303, -547.5016169023038, 0.07952103406097016, 0.24347896593902985, 121507.15752783914, -52591.48752783914, 8995879.57756326, 347515.42243674025}},
+		{"G17", []float64{9213.655022542689, -80.35570257241488, -732.5224633428288, -963.5962869881628, 247.44770734997266}},
+		{"G18", []float64{-2, -39, -63, -63, -19, -243, -31, -71, -243, -67, 4, 16, -64, 48}},
+		{"G19", []float64{28311.5, 1081, -113, 2915, 1153, -259.8}},
+		{"G20", []float64{17.0141, -0.10560519902518278, -0.129764801297648, -0.06482982171799027, -0.07299270072992702, -0.0970873786407767, -0.032441200324412, -0.027680166440105514, -0.06309709554087721, -0.11124253823393168, 0.060969548886111904, -0.15345544208619127, -0.01917063598433819, 0.10741049218029347, 0.02781584462993751, -0.001958562277474683, 0.087592407417994, 0.06321201525414845, 0.1707994782562691, 122, 137.18370900126789}},
+		{"G21", []float64{300, -255.7241168928303, -1800, -60.39999999999418, -0.026681566719623184, -0.06322527308769388, 1.365254711277414}},
+		{"G22", []float64{6000, -2426.94432362889, 20000799.999999996, 23999600, -24000400, 13001000, 7000000, -37000000, -59964000000, -14380000000, -796000000, 219.993, 170.001, -1.0001701859875798, -0.3709205658833974, 5.91842566269522, 9.892979072865522, 2.3333423662355433, -3792.5020000000004, 1443.9959999999999, -94.02100000000007}},
+		{"G23", []float64{3730, -1.83, 1.48, 290, 3.8999999999999995, 120, -110}},
+		{"G24", []float64{-4.9, -0.0398000000000005, -3.823600000000006}},
+	} {
+		prob, err := New(test.name, 0, 0)
+		if err != nil {
+			tst.Errorf("New failed:\n%v\n", err)
+			return
+		}
+		f := make([]float64, prob.Nf)
+		g := make([]float64, prob.Ng)
+		h := make([]float64, prob.Nh)
+		prob.Fcn(f, g, h, trial(prob.Xmin, prob.Xmax), nil, 0)
+		res := append(append(f, g...), h...)
+		chk.IntAssert(len(res), len(test.ref))
+		for i, v := range res {
+			chk.Scalar(tst, io.Sf("%s: %d", test.name, i), 1e-14*math.Max(1, math.Abs(v)), v, test.ref[i])
+		}
+	}
+
+	// g08 at the lower bounds: limits of sin³(2πx0)/x0³ and sin(2πx1)/(x0+x1)
+	prob := CEC06(8)
+	f, g := make([]float64, 1), make([]float64, 2)
+	prob.Fcn(f, g, nil, []float64{0, 0.25}, nil, 0)
+	chk.Scalar(tst, "G08: f(0,0.25)", 1e-10, f[0], -32*math.Pow(math.Pi, 3))
+	prob.Fcn(f, g, nil, []float64{0, 0}, nil, 0)
+	chk.Scalar(tst, "G08: f(0,0)", 1e-10, f[0], -16*math.Pow(math.Pi, 4))
+}
+
+func Test_cec06b(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cec06b. best known solutions of CEC 2006 problems")
+
+	for k := 1; k <= 24; k++ {
+		prob := CEC06(k)
+		chk.IntAssert(len(prob.Xref), len(prob.Xmin))
+		for i, v := range prob.Xref {
+			if v < prob.Xmin[i] || v > prob.Xmax[i] {
+				tst.Errorf("%s: best known solution is outside bounds. x%d=%g\n", prob.Name, i, v)
+			}
+		}
+		f := make([]float64, 1)
+		g := make([]float64, prob.Ng)
+		h := make([]float64, prob.Nh)
+		prob.Fcn(f, g, h, prob.Xref, nil, 0)
+		chk.Scalar(tst, prob.Name+": f", 1e-13*math.Max(1, math.Abs(f[0])), f[0], prob.Fref[0])
+		if k == 20 {
+			continue // slightly infeasible
+		}
+		for i, v := range g {
+			if v < -1e-12 {
+				tst.Errorf("%s: best known solution is infeasible. g%d=%g\n", prob.Name, i, v)
+			}
+		}
+		for i, v := range h {
+			if math.Abs(v) > prob.EpsH+1e-10 { // best known solutions are on the tolerance boundary
+				tst.Errorf("%s: best known solution is infeasible. h%d=%g\n", prob.Name, i, v)
+			}
+		}
+	}
+}
+
+func Test_cec06c(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cec06c. solving g06, g08 and g24")
+
+	for _, k := range []int{6, 8, 24} {
+		var opt goga.Optimiser
+		opt.Default()
+		opt.Nsol = 40
+		opt.Ncpu = 1
+		opt.Tf = 500
+		opt.Verbose = false
+		prob := CEC06(k)
+		prob.Init(&opt)
+		chk.Scalar(tst, "EpsH", 1e-17, opt.EpsH, 1e-4)
+		chk.Vector(tst, "RptXref", 1e-17, opt.RptXref, prob.Xref)
+		opt.Solve()
+		fbest := math.Inf(1)
+		for _, sol := range opt.Solutions {
+			if sol.Feasible() {
+				fbest = math.Min(fbest, sol.Ova[0])
+			}
+		}
+		io.Pforan("%s: best f = %v (%v)\n", prob.Name, fbest, prob.Fref[0])
+		if math.Abs(fbest-prob.Fref[0]) > 1e-4*math.Max(1, math.Abs(prob.Fref[0])) {
+			tst.Errorf("%s: best solution is too far from the best known one. %g != %g\n", prob.Name, fbest, prob.Fref[0])
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_cec01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cec01. UF and CF problems")
+
+	// values computed with the C++ code of the CEC 2009 competition (f followed by c)
+	for _, test := range []struct {
+		name string
+		ref  []float64
+	}{
+		{"UF1", []float64{2.2719669163303378, 2.1977367701019643}},
+		{"UF2", []float64{1.103634834005796, 1.2871539382903201}},
+		{"UF3", []float64{1.9312235716272892, 2.2895275498790957}},
+		{"UF4", []float64{0.46837672153750043, 1.0714106790579474}},
+		{"UF5", []float64{5.4615703078006934, 5.5177419903494132}},
+		{"UF6", []float64{8.4736878640745399, 7.947641644118665}},
+		{"UF7", []float64{2.7579700019269606, 1.9594562420105079}},
+		{"UF8", []float64{11.751025347055334, 2.0503773970121828, 9.0572465529209101}},
+		{"UF9", []float64{12.249025347055333, 2.0573708728238147, 8.6032560531813633}},
+		{"UF10", []float64{49.020565540786876, 7.4978039257073839, 37.386032114085715}},
+		{"CF1", []float64{0.55078274348109701, 0.99834092133122665, -0.44793540428389089}},
+		{"CF2", []float64{2.4350406530937789, 1.6297201615948256, 7.583311765031354e-05}},
+		{"CF3", []float64{18.572170975122326, 25.382615046512889, 369.57456538913266}},
+		{"CF4", []float64{9.1556541151876445, 12.684289975563459, 0.0004721152174899179}},
+		{"CF5", []float64{14.239204935695774, 21.222466110773208, 2.1000000000000005}},
+		{"CF6", []float64{5.085023617232558, 11.869029594135233, 2.3741657386773944, -0.96578857384364314}},
+		{"CF7", []float64{10.758245590302227, 25.744772770393713, 2.3741657386773953, -1.4125053655859221}},
+		{"CF8", []float64{8.4424974591853399, 33.976566608621177, 2.3573755934064655, -273.50328469348347}},
+		{"CF9", []float64{0.96660472209259851, 15.43378656640477, 3.1136603874064099, -31.417198627725046}},
+		{"CF10", []float64{5.5788372117663201, 61.206935196273406, 13.366068552592303, -21.741192298128659}},
+	} {
+		prob, err := New(test.name, 0, 0)
+		if err != nil {
+			tst.Errorf("New failed:\n%v\n", err)
+			return
+		}
+		f := make([]float64, prob.Nf)
+		g := make([]float64, prob.Ng)
+		prob.Fcn(f, g, nil, trial(prob.Xmin, prob.Xmax), nil, 0)
+		chk.Vector(tst, test.name, 1e-12, append(f, g...), test.ref)
+	}
+}
+
+func Test_cec02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cec02. Pareto optimal solutions of UF problems")
+
+	// UF1, UF4 and UF7: xj = sin(6πx1 + jπ/n)
+	n := 30
+	x := make([]float64, n)
+	f := make([]float64, 3)
+	for k, y := range map[int]func(f0 float64) float64{
+		1: func(f0 float64) float64 { return 1 - math.Sqrt(f0) },
+		4: func(f0 float64) float64 { return 1 - f0*f0 },
+		7: func(f0 float64) float64 { return 1 - f0 },
+	} {
+		prob := UF(k, n)
+		for _, x0 := range []float64{0, 0.1, 0.35, 0.8, 1} {
+			x[0] = x0
+			for j := 2; j <= n; j++ {
+				x[j-1] = math.Sin(6*math.Pi*x0 + float64(j)*math.Pi/float64(n))
+			}
+			prob.Fcn(f, nil, nil, x, nil, 0)
+			chk.Scalar(tst, io.Sf("UF%d: f1(f0)", k), 1e-15, f[1], y(f[0]))
+		}
+	}
+
+	// UF8 and UF10: xj = 2x2 sin(2πx1 + jπ/n)
+	for _, k := range []int{8, 10} {
+		prob := UF(k, n)
+		for _, x01 := range [][]float64{{0, 0}, {0.2, 0.7}, {1, 0.5}} {
+			x[0], x[1] = x01[0], x01[1]
+			for j := 3; j <= n; j++ {
+				x[j-1] = 2 * x[1] * math.Sin(2*math.Pi*x[0]+float64(j)*math.Pi/float64(n))
+			}
+			prob.Fcn(f, nil, nil, x, nil, 0)
+			chk.Scalar(tst, io.Sf("UF%d: Σf²", k), 1e-15, f[0]*f[0]+f[1]*f[1]+f[2]*f[2], 1)
+		}
+	}
+}
+
+func Test_cec03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("cec03. fronts of UF and CF problems against published data")
+
+	for _, name := range Names() {
+		if name[0] != 'U' && name[0] != 'C' {
+			continue
+		}
+		dat, err := io.ReadMatrix("../examples/mulobj-cec09/cec09/pf_data/" + name + ".dat")
+		if err != nil {
+			tst.Errorf("cannot read published front:\n%v\n", err)
+			return
+		}
+		var ref [][]float64
+		for i := 0; i < len(dat); i += 1 + len(dat)/500 {
+			ref = append(ref, dat[i])
+		}
+		prob, _ := New(name, 0, 0)
+		F := prob.Front(2000)
+		tol := 0.01
+		if prob.Nf == 3 {
+			tol = 0.03
+		}
+		d := maxDist(ref, F)
+		io.Pforan("%-5s: npts=%4d maximum distance from published points = %g\n", name, len(F), d)
+		if d > tol {
+			tst.Errorf("%s: published points are too far from the front. %g > %g\n", name, d, tol)
+		}
+	}
+}
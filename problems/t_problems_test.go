@@ -0,0 +1,265 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func init() {
+	io.Verbose = false
+}
+
+func verbose() {
+	io.Verbose = true
+	chk.Verbose = true
+}
+
+// trial returns a trial point in the box [xmin,xmax]
+func trial(xmin, xmax []float64) []float64 {
+	x := make([]float64, len(xmin))
+	for i := range x {
+		x[i] = xmin[i] + (xmax[i]-xmin[i])*float64((7*i+3)%11)/10
+	}
+	return x
+}
+
+// maxDist returns the maximum distance between the points in A and their closest points in B
+func maxDist(A, B [][]float64) (res float64) {
+	for _, a := range A {
+		dmin := math.Inf(1)
+		for _, b := range B {
+			d := 0.0
+			for k := range a {
+				d += (a[k] - b[k]) * (a[k] - b[k])
+			}
+			dmin = math.Min(dmin, d)
+		}
+		res = math.Max(res, math.Sqrt(dmin))
+	}
+	return
+}
+
+func Test_problems01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("problems01. names and errors")
+
+	names := Names()
+	chk.IntAssert(len(names), 6+7+9+10+10+24+24)
+	for _, name := range names {
+		prob, err := New(strings.ToLower(name), 0, 0)
+		if err != nil {
+			tst.Errorf("New failed:\n%v\n", err)
+			return
+		}
+		if prob.Name != name {
+			tst.Errorf("name of problem is incorrect: %q != %q\n", prob.Name, name)
+		}
+		if prob.BinInt == 0 && (len(prob.Xmin) == 0 || len(prob.Xmin) != len(prob.Xmax)) {
+			tst.Errorf("%s: bounds are incorrect\n", name)
+		}
+	}
+
+	// dimensions
+	prob, _ := New("DTLZ2", 5, 0)
+	chk.IntAssert(prob.Nf, 5)
+	chk.IntAssert(len(prob.Xmin), 14)
+	prob, _ = New("WFG4", 3, 24)
+	chk.IntAssert(prob.Nf, 3)
+	chk.IntAssert(len(prob.Xmin), 24)
+	prob, _ = New("CF6", 0, 0)
+	chk.IntAssert(prob.Ng, 2)
+	prob, _ = New("ZDT5", 0, 0)
+	chk.IntAssert(prob.BinInt, 80)
+	prob, _ = New("g20", 0, 0)
+	chk.IntAssert(len(prob.Xmin), 24)
+	chk.IntAssert(prob.Ng, 6)
+	chk.IntAssert(prob.Nh, 14)
+
+	// errors
+	for _, test := range []struct {
+		name   string
+		nf, nx int
+	}{
+		{"ZDT", 0, 0}, {"ZDT7", 0, 0}, {"XYZ1", 0, 0}, {"DTLZ2", 1, 0}, {"DTLZ2", 3, 2},
+		{"WFG1", 3, 4}, {"WFG2", 2, 5}, {"UF8", 0, 4}, {"CF6", 0, 3}, {"G0", 0, 0}, {"G25", 0, 0},
+		{"BBOB25", 0, 0}, {"BBOB1", 0, 1},
+	} {
+		_, err := New(test.name, test.nf, test.nx)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("New should have failed with %q (nf=%d, nx=%d)\n", test.name, test.nf, test.nx)
+		}
+	}
+}
+
+func Test_problems02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("problems02. ZDT problems")
+
+	// objective values
+	f := make([]float64, 2)
+	x := make([]float64, 30)
+	for i := range x {
+		x[i] = 0.5
+	}
+	c := 1 + 9*0.5
+	ZDT(1, 0).Fcn(f, nil, nil, x, nil, 0)
+	chk.Vector(tst, "ZDT1", 1e-15, f, []float64{0.5, c * (1 - math.Sqrt(0.5/c))})
+	ZDT(2, 0).Fcn(f, nil, nil, x, nil, 0)
+	chk.Vector(tst, "ZDT2", 1e-15, f, []float64{0.5, c * (1 - 0.25/c/c)})
+	ZDT(3, 0).Fcn(f, nil, nil, x, nil, 0)
+	chk.Vector(tst, "ZDT3", 1e-14, f, []float64{0.5, c * (1 - math.Sqrt(0.5/c) - 0.5/c*math.Sin(5*math.Pi))})
+	ZDT(4, 0).Fcn(f, nil, nil, x[:10], nil, 0)
+	c = 1 + 10*9 + 9*(0.25-10*math.Cos(2*math.Pi))
+	chk.Vector(tst, "ZDT4", 1e-13, f, []float64{0.5, c * (1 - math.Sqrt(0.5/c))})
+	ZDT(6, 0).Fcn(f, nil, nil, x[:10], nil, 0)
+	f0 := 1 - math.Exp(-2)*math.Pow(math.Sin(3*math.Pi), 6)
+	c = 1 + 9*math.Pow(0.5, 0.25)
+	chk.Vector(tst, "ZDT6", 1e-14, f, []float64{f0, c * (1 - f0*f0/c/c)})
+
+	// binary problem
+	prob := ZDT(5, 0)
+	ξ := make([]int, prob.BinInt)
+	prob.Fcn(f, nil, nil, nil, ξ, 0)
+	chk.Vector(tst, "ZDT5: zeros", 1e-15, f, []float64{1, 20})
+	for i := range ξ {
+		ξ[i] = 1
+	}
+	prob.Fcn(f, nil, nil, nil, ξ, 0)
+	chk.Vector(tst, "ZDT5: ones", 1e-15, f, []float64{31, 10.0 / 31.0})
+
+	// fronts
+	for k, y := range []func(f0 float64) float64{
+		func(f0 float64) float64 { return 1 - math.Sqrt(f0) },
+		func(f0 float64) float64 { return 1 - f0*f0 },
+		func(f0 float64) float64 { return 1 - math.Sqrt(f0) - f0*math.Sin(10*math.Pi*f0) },
+		func(f0 float64) float64 { return 1 - math.Sqrt(f0) },
+		func(f0 float64) float64 { return 10 / f0 },
+		func(f0 float64) float64 { return 1 - f0*f0 },
+	} {
+		F := ZDT(k+1, 0).Front(200)
+		for _, f := range F {
+			chk.Scalar(tst, io.Sf("ZDT%d: f1(f0)", k+1), 1e-15, f[1], y(f[0]))
+		}
+	}
+
+	// ZDT3: disconnected front (Deb 2001)
+	F := ZDT(3, 0).Front(2000)
+	ranges := [][]float64{
+		{0.000000000000000, 0.083001534925223},
+		{0.182228728029413, 0.257762363387862},
+		{0.409313674808657, 0.453882104088830},
+		{0.618396794416602, 0.652511703804663},
+		{0.823331798326633, 0.851832865436414},
+	}
+	for _, f := range F {
+		inside := false
+		for _, r := range ranges {
+			if f[0] >= r[0]-1e-3 && f[0] <= r[1]+1e-3 {
+				inside = true
+			}
+		}
+		if !inside {
+			tst.Errorf("ZDT3: f0=%g is not in the Pareto front\n", f[0])
+			return
+		}
+	}
+
+	// ZDT6: minimum f0
+	F = ZDT(6, 0).Front(5000)
+	chk.Scalar(tst, "ZDT6: f0min", 1e-5, F[0][0], 0.2807753188153699)
+}
+
+func Test_problems03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("problems03. DTLZ problems")
+
+	// objective values of DTLZ1 and DTLZ2 with M=3
+	x := []float64{0.2, 0.7, 0.5, 0.5, 0.5, 0.5, 0.5}
+	f := make([]float64, 3)
+	DTLZ(1, 3, 0).Fcn(f, nil, nil, x, nil, 0)
+	chk.Vector(tst, "DTLZ1", 1e-15, f, []float64{0.5 * 0.2 * 0.7, 0.5 * 0.2 * 0.3, 0.5 * 0.8})
+	x = append(x, 0.5, 0.5, 0.5, 0.5, 0.5)
+	x[len(x)-1] = 1
+	DTLZ(2, 3, 0).Fcn(f, nil, nil, x, nil, 0)
+	c, a, b := 1.25, 0.2*math.Pi/2, 0.7*math.Pi/2
+	chk.Vector(tst, "DTLZ2", 1e-15, f, []float64{c * math.Cos(a) * math.Cos(b), c * math.Cos(a) * math.Sin(b), c * math.Sin(a)})
+
+	// fronts
+	for _, nf := range []int{2, 3, 5} {
+		for k := 1; k <= 7; k++ {
+			prob := DTLZ(k, nf, 0)
+			F := prob.Front(300)
+			if len(F) < 50 {
+				tst.Errorf("DTLZ%d: there should be more points on the front. %d < 50\n", k, len(F))
+			}
+			for _, f := range F {
+				sum := 0.0
+				switch k {
+				case 1:
+					for _, v := range f {
+						sum += v
+					}
+					chk.Scalar(tst, "DTLZ1: Σf", 1e-15, sum, 0.5)
+				case 7:
+					for _, v := range f[:nf-1] {
+						sum += v * (1 + math.Sin(3*math.Pi*v))
+					}
+					chk.Scalar(tst, "DTLZ7: f", 1e-14, f[nf-1], float64(nf)-sum)
+				default:
+					for _, v := range f {
+						sum += v * v
+					}
+					chk.Scalar(tst, io.Sf("DTLZ%d: Σf²", k), 1e-15, sum, 1)
+				}
+			}
+		}
+	}
+
+	// degenerate fronts
+	for _, f := range DTLZ(5, 3, 0).Front(50) {
+		chk.Scalar(tst, "DTLZ5: f0-f1", 1e-15, f[0], f[1])
+	}
+}
+
+func Test_problems04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("problems04. solving ZDT1")
+
+	var opt goga.Optimiser
+	opt.Default()
+	opt.Nsol = 50
+	opt.Ncpu = 2
+	opt.Tf = 200
+	opt.Verbose = false
+	prob := ZDT(1, 10)
+	prob.Init(&opt)
+	chk.String(tst, opt.RptName, "ZDT1")
+	chk.IntAssert(opt.Nflt, 10)
+	opt.Solve()
+
+	var F [][]float64
+	for _, sol := range opt.Solutions {
+		if sol.FrontId == 0 {
+			F = append(F, sol.Ova)
+		}
+	}
+	dist := maxDist(F, prob.Front(500))
+	io.Pforan("number of points on front = %d. maximum distance to Pareto front = %g\n", len(F), dist)
+	if dist > 0.05 {
+		tst.Errorf("solutions should be close to the Pareto front. %g > 0.05\n", dist)
+	}
+}
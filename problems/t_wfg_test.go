@@ -0,0 +1,100 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_wfg01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("wfg01. WFG problems")
+
+	// values computed with the C++ code of the WFG toolkit (kpos = 2(M-1), lpos = 10)
+	for _, test := range []struct {
+		nf  int
+		ref [][]float64
+	}{
+		{2, [][]float64{
+			{2.9565118112161257, 0.98175311958930711},
+			{1.5586658742311057, 3.3036630036630052},
+			{1.9036630036630036, 2.0036630036630036},
+			{1.940081787698829, 2.8379193228779962},
+			{1.8393550067191557, 3.528177924405616},
+			{2.6738781796917737, 1.3242393586624217},
+			{2.2107748342026867, 2.5954887643583118},
+			{2.4627137787938924, 2.8474277089495037},
+			{2.6356793053264975, 1.6250477122275111},
+		}},
+		{3, [][]float64{
+			{2.8893739816688448, 0.98640270010853415, 0.98443603742490104},
+			{0.90033803655036715, 1.5052812526511063, 4.7679487179487205},
+			{1.2746153846153847, 2.2046153846153844, 2.8179487179487177},
+			{0.91116854763094945, 3.4592265558224486, 4.1571395438258092},
+			{1.5509993497941856, 1.9658512315053258, 4.9774657378276208},
+			{2.2440149350192367, 3.4195939689679715, 1.7146307662653608},
+			{1.2070744563912654, 3.0835638639272638, 4.8009623895446074},
+			{1.7058697647352883, 3.4627326686925919, 3.8385225247918502},
+			{0.88150557151904507, 0.91699324374500157, 6.7760207574453597},
+		}},
+	} {
+		for k, ref := range test.ref {
+			prob := WFG(k+1, test.nf, 2*(test.nf-1), 10)
+			f := make([]float64, test.nf)
+			prob.Fcn(f, nil, nil, trial(prob.Xmin, prob.Xmax), nil, 0)
+			chk.Vector(tst, io.Sf("WFG%d: M=%d", k+1, test.nf), 1e-14, f, ref)
+		}
+	}
+}
+
+func Test_wfg02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("wfg02. fronts of WFG problems")
+
+	for _, nf := range []int{2, 3} {
+		for k := 1; k <= 9; k++ {
+			F := WFG(k, nf, 2*(nf-1), 10).Front(400)
+			if len(F) < 100 {
+				tst.Errorf("WFG%d: there should be more points on the front. %d < 100\n", k, len(F))
+			}
+
+			// extreme points
+			fmax := make([]float64, nf)
+			for _, f := range F {
+				for m, v := range f {
+					fmax[m] = math.Max(fmax[m], v)
+				}
+			}
+			if k != 3 {
+				for m := 0; m < nf; m++ {
+					chk.Scalar(tst, io.Sf("WFG%d: max(f%d)", k, m), 1e-14, fmax[m], 2*float64(m+1))
+				}
+			}
+
+			// shapes
+			for _, f := range F {
+				sum := 0.0
+				switch {
+				case k == 3:
+					for m, v := range f {
+						sum += v / (2 * float64(m+1))
+					}
+					chk.Scalar(tst, "WFG3: linear", 1e-15, sum, 1)
+				case k > 3:
+					for m, v := range f {
+						sum += math.Pow(v/(2*float64(m+1)), 2)
+					}
+					chk.Scalar(tst, io.Sf("WFG%d: concave", k), 1e-15, sum, 1)
+				}
+			}
+		}
+	}
+}
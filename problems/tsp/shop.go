@@ -0,0 +1,108 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"math"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+)
+
+// FlowShop holds the data of a permutation flow-shop scheduling problem: all jobs visit the
+// machines in the same order 0, 1, ..., nmach-1 and the sequence of jobs is the same on all machines
+type FlowShop struct {
+	P [][]float64 // [njobs][nmach] processing times
+}
+
+// Makespan computes the completion time of the last job on the last machine given the sequence
+// of jobs in perm
+//  Example:
+//     P = [[3 2]    perm = [1 0]   machine 0: job 1 [0,1), job 0 [1,4)
+//          [1 4]]                  machine 1: job 1 [1,5), job 0 [5,7)   =>  makespan = 7
+func (o *FlowShop) Makespan(perm []int) float64 {
+	nmach := len(o.P[0])
+	C := make([]float64, nmach) // completion times of previous job on each machine
+	for _, job := range perm {
+		for m := 0; m < nmach; m++ {
+			start := C[m]
+			if m > 0 {
+				start = math.Max(start, C[m-1])
+			}
+			C[m] = start + o.P[job][m]
+		}
+	}
+	return C[nmach-1]
+}
+
+// MinProb implements goga.MinProb_t: f[0] is the makespan of the sequence of jobs ξ
+func (o *FlowShop) MinProb(f, g, h, x []float64, ξ []int, cpu int) {
+	f[0] = o.Makespan(ξ)
+}
+
+// Init initialises optimiser to solve this problem with permutations of size njobs
+func (o *FlowShop) Init(opt *goga.Optimiser) {
+	opt.PermInt = len(o.P)
+	opt.FltMin, opt.FltMax = nil, nil
+	opt.Init(goga.GenTrialSolutions, nil, o.MinProb, 1, 0, 0)
+}
+
+// JobShop holds the data of a job-shop scheduling problem: each job has one operation on each
+// machine and the order of machines is given for each job
+//  Representation: a permutation of {0,1,...,njobs*nmach-1} where item v corresponds to job v/nmach.
+//  The k-th occurrence of a job in the permutation is the k-th operation of that job; i.e. the
+//  permutation is decoded as an operation-based (permutation with repetition) chromosome [1].
+//  Schedules are semi-active: each operation starts as soon as the job and machine are free.
+//  Reference:
+//   [1] Bierwirth C. A generalized permutation approach to job shop scheduling with genetic
+//       algorithms. OR Spektrum, 17:87-92; 1995. doi:10.1007/BF01719250
+type JobShop struct {
+	Mach [][]int     // [njobs][nmach] machine of each operation of each job
+	Time [][]float64 // [njobs][nmach] processing time of each operation of each job
+}
+
+// Size returns the size of permutations; i.e. njobs * nmach
+func (o *JobShop) Size() int {
+	return len(o.Mach) * len(o.Mach[0])
+}
+
+// Makespan computes the completion time of the last operation of the schedule decoded from perm
+//  Example:
+//     Mach = [[0 1]   Time = [[3 2]    perm = [2 0 1 3] => jobs [1 0 0 1]
+//             [1 0]]          [1 4]]
+//     job 1, op 0: machine 1 [0,1)      job 0, op 1: machine 1 [3,5)
+//     job 0, op 0: machine 0 [0,3)      job 1, op 1: machine 0 [3,7)   =>  makespan = 7
+func (o *JobShop) Makespan(perm []int) float64 {
+	njobs, nmach := len(o.Mach), len(o.Mach[0])
+	if len(perm) != njobs*nmach {
+		chk.Panic("size of permutation must be equal to njobs * nmach = %d. %d is invalid", njobs*nmach, len(perm))
+	}
+	nextOp := make([]int, njobs)
+	jobReady := make([]float64, njobs)
+	machReady := make([]float64, nmach)
+	makespan := 0.0
+	for _, v := range perm {
+		job := v / nmach
+		op := nextOp[job]
+		m := o.Mach[job][op]
+		end := math.Max(jobReady[job], machReady[m]) + o.Time[job][op]
+		jobReady[job], machReady[m] = end, end
+		nextOp[job]++
+		makespan = math.Max(makespan, end)
+	}
+	return makespan
+}
+
+// MinProb implements goga.MinProb_t: f[0] is the makespan of the schedule decoded from ξ
+func (o *JobShop) MinProb(f, g, h, x []float64, ξ []int, cpu int) {
+	f[0] = o.Makespan(ξ)
+}
+
+// Init initialises optimiser to solve this problem with permutations of size njobs*nmach
+func (o *JobShop) Init(opt *goga.Optimiser) {
+	opt.PermInt = o.Size()
+	opt.FltMin, opt.FltMax = nil, nil
+	opt.Init(goga.GenTrialSolutions, nil, o.MinProb, 1, 0, 0)
+}
@@ -0,0 +1,195 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+)
+
+func init() {
+	io.Verbose = false
+}
+
+func verbose() {
+	io.Verbose = true
+	chk.Verbose = true
+}
+
+// heldKarp computes the optimal tour length by dynamic programming (small instances only)
+func heldKarp(D [][]float64) float64 {
+	n := len(D)
+	N := 1 << uint(n)
+	dp := make([]float64, N*n)
+	for i := range dp {
+		dp[i] = math.Inf(1)
+	}
+	dp[1*n+0] = 0
+	for S := 1; S < N; S += 2 {
+		for j := 0; j < n; j++ {
+			v := dp[S*n+j]
+			if math.IsInf(v, 1) {
+				continue
+			}
+			for k := 0; k < n; k++ {
+				if S&(1<<uint(k)) == 0 {
+					T := S | (1 << uint(k))
+					dp[T*n+k] = math.Min(dp[T*n+k], v+D[j][k])
+				}
+			}
+		}
+	}
+	best := math.Inf(1)
+	for j := 1; j < n; j++ {
+		best = math.Min(best, dp[(N-1)*n+j]+D[j][0])
+	}
+	return best
+}
+
+func Test_tsp01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("tsp01. bundled instances and known optimal lengths")
+
+	for _, name := range []string{"burma14", "ulysses16"} {
+		inst, err := ReadTsplib("data/" + name + ".tsp")
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		lopt, ok := inst.OptimalLength()
+		if !ok {
+			tst.Errorf("optimal length of %q is not available\n", name)
+			return
+		}
+		lhk := heldKarp(inst.D)
+		io.Pforan("%s: optimal length = %g (Held-Karp = %g)\n", name, lopt, lhk)
+		chk.Scalar(tst, name, 1e-15, lhk, lopt)
+	}
+}
+
+func Test_tsp02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("tsp02. explicit and ATT distances")
+
+	// explicit
+	correct := [][]float64{
+		{0, 3, 4, 2, 7},
+		{3, 0, 4, 6, 3},
+		{4, 4, 0, 5, 8},
+		{2, 6, 5, 0, 6},
+		{7, 3, 8, 6, 0},
+	}
+	for _, name := range []string{"small5", "small5l"} {
+		inst, err := ReadTsplib("data/" + name + ".tsp")
+		if err != nil {
+			tst.Errorf("%v\n", err)
+			return
+		}
+		for i := 0; i < 5; i++ {
+			chk.Vector(tst, io.Sf("%s: D[%d]", name, i), 1e-15, inst.D[i], correct[i])
+		}
+	}
+
+	// ATT: sqrt((30²+40²)/10) = 15.81 => 16; sqrt(100/10) = 3.16 => 4; sqrt(10000/10) = 31.62 => 32
+	inst, err := ReadTsplib("data/att4.tsp")
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	chk.Scalar(tst, "D[0][1]", 1e-15, inst.D[0][1], 16)
+	chk.Scalar(tst, "D[0][2]", 1e-15, inst.D[0][2], 4)
+	chk.Scalar(tst, "D[3][0]", 1e-15, inst.D[3][0], 32)
+}
+
+func Test_tsp03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("tsp03. burma14 with goga")
+
+	// instance
+	inst, err := ReadTsplib("data/burma14.tsp")
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+
+	// optimiser
+	var opt goga.Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 2
+	opt.Tf = 100
+	opt.Verbose = false
+	opt.IntPm = 0.2
+	opt.Perm2opt = true
+	inst.Init(&opt)
+	opt.Solve()
+
+	// check
+	best, _ := goga.GetBestFeasible(&opt, 0)
+	lopt, _ := inst.OptimalLength()
+	io.Pforan("best length = %g (optimal = %g)\n", best.Ova[0], lopt)
+	if best.Ova[0] < lopt {
+		tst.Errorf("best tour is shorter than the optimal tour\n")
+	}
+	chk.Scalar(tst, "length", 1e-15, goga.TourLength(best.Int, inst.D), best.Ova[0])
+
+	// plot
+	if chk.Verbose {
+		plt.SetForEps(1.0, 400)
+		inst.PlotBest("/tmp/goga", "fig_tsp03", &opt)
+	}
+}
+
+func Test_shop01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("shop01. flow-shop and job-shop makespans")
+
+	fs := FlowShop{P: [][]float64{{3, 2}, {1, 4}}}
+	chk.Scalar(tst, "flow-shop: [1 0]", 1e-15, fs.Makespan([]int{1, 0}), 7)
+	chk.Scalar(tst, "flow-shop: [0 1]", 1e-15, fs.Makespan([]int{0, 1}), 9)
+
+	js := JobShop{
+		Mach: [][]int{{0, 1}, {1, 0}},
+		Time: [][]float64{{3, 2}, {1, 4}},
+	}
+	chk.Scalar(tst, "job-shop: [2 0 1 3]", 1e-15, js.Makespan([]int{2, 0, 1, 3}), 7)
+	chk.Scalar(tst, "job-shop: [0 1 2 3]", 1e-15, js.Makespan([]int{0, 1, 2, 3}), 10)
+}
+
+func Test_tsp04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("tsp04. malformed TSPLIB files")
+
+	head := "NAME: bad\nTYPE: TSP\nDIMENSION: 3\nEDGE_WEIGHT_TYPE: EUC_2D\nNODE_COORD_SECTION\n1 0 0\n2 3 4\n"
+	for i, test := range []struct {
+		txt, msg string
+	}{
+		{"NAME: bad\nTYPE: TSP\nDIMENSION: three\n", "line 3"},
+		{head + "4 1 1\n", "line 8"},
+		{head + "0 1 1\n", "line 8"},
+		{head + "x 1 1\n", "line 8"},
+		{head + "3 1 1.2.3\n", "line 8"},
+		{"NAME: bad\nTYPE: TSP\nDIMENSION: 3\nEDGE_WEIGHT_TYPE: EXPLICIT\nEDGE_WEIGHT_FORMAT: UPPER_ROW\nEDGE_WEIGHT_SECTION\n1 2\nthree\n", "line 8"},
+	} {
+		fn := io.Sf("tsp04-bad%d.tsp", i)
+		io.WriteFileSD("/tmp/goga", fn, test.txt)
+		_, err := ReadTsplib("/tmp/goga/" + fn)
+		io.Pforan("%v\n", err)
+		if err == nil || !strings.Contains(err.Error(), test.msg) {
+			tst.Errorf("ReadTsplib should have failed in %s\n", test.msg)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tsp
+
+import (
+	"github.com/cpmech/goga"
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+)
+
+// MinProb implements goga.MinProb_t: f[0] is the length of the closed tour ξ
+func (o *Instance) MinProb(f, g, h, x []float64, ξ []int, cpu int) {
+	f[0] = goga.TourLength(ξ, o.D)
+}
+
+// Init initialises optimiser to solve this instance with permutations of size Dim
+//  Note: other parameters such as Nsol, Tf, Perm2opt, CxInt, MtInt may be set before calling Init
+func (o *Instance) Init(opt *goga.Optimiser) {
+	opt.PermInt = o.Dim
+	opt.DistMat = o.D
+	opt.FltMin, opt.FltMax = nil, nil
+	opt.Init(goga.GenTrialSolutions, nil, o.MinProb, 1, 0, 0)
+}
+
+// OptimalLength returns the known optimal tour length of this instance, if available
+func (o *Instance) OptimalLength() (length float64, ok bool) {
+	length, ok = Optimal[o.Name]
+	return
+}
+
+// PlotTour plots closed tour
+//  args -- extra arguments for the tour line; e.g. "'r-', lw=2"
+func (o *Instance) PlotTour(tour []int, args string, withIds bool) {
+	if o.X == nil {
+		chk.Panic("cannot plot tour of instance %q without coordinates", o.Name)
+	}
+	n := len(tour)
+	x, y := make([]float64, n+1), make([]float64, n+1)
+	for i, node := range tour {
+		x[i], y[i] = o.X[node], o.Y[node]
+	}
+	x[n], y[n] = x[0], y[0]
+	if args == "" {
+		args = "'b-', marker='o', markerfacecolor='none', clip_on=0"
+	}
+	plt.Plot(x, y, args)
+	if withIds {
+		for _, node := range tour {
+			plt.Text(o.X[node], o.Y[node], io.Sf("%d", node+1), "size=7, ha='left', va='bottom'")
+		}
+	}
+	plt.Equal()
+}
+
+// PlotBest plots the tour of the best solution in optimiser and saves figure
+func (o *Instance) PlotBest(dirout, fnkey string, opt *goga.Optimiser) {
+	best, _ := goga.GetBestFeasible(opt, 0)
+	if best == nil {
+		return
+	}
+	o.PlotTour(best.Int, "", false)
+	plt.Title(io.Sf("%s: length = %g", o.Name, best.Ova[0]), "")
+	plt.Gll("$x$", "$y$", "")
+	plt.SaveD(dirout, fnkey+".eps")
+}
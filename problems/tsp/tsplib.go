@@ -0,0 +1,250 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// package tsp implements travelling salesman and shop scheduling problems with permutations
+package tsp
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// Optimal holds the known optimal tour lengths of bundled instances (see data directory)
+var Optimal = map[string]float64{
+	"burma14":   3323,
+	"ulysses16": 6859,
+}
+
+// Instance holds the data of a TSPLIB instance
+//  Reference:
+//   [1] Reinelt G. TSPLIB—A Traveling Salesman Problem Library. ORSA Journal on Computing,
+//       3(4):376-384; 1991. doi:10.1287/ijoc.3.4.376
+type Instance struct {
+	Name       string      // name of instance
+	Comment    string      // comment
+	Dim        int         // number of nodes
+	WeightType string      // EDGE_WEIGHT_TYPE: EUC_2D, CEIL_2D, GEO, ATT or EXPLICIT
+	WeightFmt  string      // EDGE_WEIGHT_FORMAT for EXPLICIT: FULL_MATRIX, UPPER_ROW, LOWER_ROW, UPPER_DIAG_ROW or LOWER_DIAG_ROW
+	X, Y       []float64   // coordinates of nodes (or display coordinates). may be nil for explicit instances
+	D          [][]float64 // [dim][dim] distance matrix
+}
+
+// ReadTsplib reads a TSPLIB file (symmetric TSP only)
+//  Note: invalid numbers and node numbers are reported with line numbers
+func ReadTsplib(filename string) (o *Instance, err error) {
+
+	// read file
+	b, err := io.ReadFile(filename)
+	if err != nil {
+		return nil, chk.Err("cannot read TSPLIB file %q:\n%v", filename, err)
+	}
+	o = new(Instance)
+
+	// parse specification and data sections
+	var weights []float64
+	section := ""
+	for k, line := range strings.Split(string(b), "\n") {
+		lnum := k + 1
+		line = strings.TrimSpace(line)
+		if line == "" || line == "EOF" {
+			continue
+		}
+		if strings.Contains(line, ":") {
+			kv := strings.SplitN(line, ":", 2)
+			key, val := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+			section = ""
+			switch key {
+			case "NAME":
+				o.Name = strings.TrimSuffix(val, ".tsp")
+			case "COMMENT":
+				o.Comment = val
+			case "TYPE":
+				if val != "TSP" {
+					return nil, chk.Err("TSPLIB type %q is not available. only TSP can be read", val)
+				}
+			case "DIMENSION":
+				if o.X != nil || weights != nil {
+					return nil, chk.Err("line %d: DIMENSION must be given before the data sections", lnum)
+				}
+				o.Dim, err = strconv.Atoi(val)
+				if err != nil || o.Dim < 1 {
+					return nil, chk.Err("line %d: DIMENSION %q is invalid", lnum, val)
+				}
+			case "EDGE_WEIGHT_TYPE":
+				o.WeightType = val
+			case "EDGE_WEIGHT_FORMAT":
+				o.WeightFmt = val
+			}
+			continue
+		}
+		switch line {
+		case "NODE_COORD_SECTION", "DISPLAY_DATA_SECTION", "EDGE_WEIGHT_SECTION":
+			if o.Dim < 1 {
+				return nil, chk.Err("line %d: DIMENSION must be given before %s", lnum, line)
+			}
+			section = line
+			if section != "EDGE_WEIGHT_SECTION" && o.X == nil {
+				o.X = make([]float64, o.Dim)
+				o.Y = make([]float64, o.Dim)
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		switch section {
+		case "NODE_COORD_SECTION", "DISPLAY_DATA_SECTION":
+			if len(fields) < 3 {
+				return nil, chk.Err("line %d: coordinates line %q is invalid", lnum, line)
+			}
+			id, err := strconv.Atoi(fields[0])
+			if err != nil || id < 1 || id > len(o.X) {
+				return nil, chk.Err("line %d: node number %q is invalid. it must be in [1,%d]", lnum, fields[0], len(o.X))
+			}
+			x, errx := strconv.ParseFloat(fields[1], 64)
+			y, erry := strconv.ParseFloat(fields[2], 64)
+			if errx != nil || erry != nil {
+				return nil, chk.Err("line %d: coordinates of node %d are invalid: %q", lnum, id, line)
+			}
+			o.X[id-1], o.Y[id-1] = x, y
+		case "EDGE_WEIGHT_SECTION":
+			for _, f := range fields {
+				w, err := strconv.ParseFloat(f, 64)
+				if err != nil {
+					return nil, chk.Err("line %d: edge weight %q is invalid", lnum, f)
+				}
+				weights = append(weights, w)
+			}
+		}
+	}
+	if o.Dim < 1 {
+		return nil, chk.Err("DIMENSION is missing in TSPLIB file %q", filename)
+	}
+
+	// distances
+	o.D = make([][]float64, o.Dim)
+	for i := 0; i < o.Dim; i++ {
+		o.D[i] = make([]float64, o.Dim)
+	}
+	if o.WeightType == "EXPLICIT" {
+		err = o.fillExplicit(weights)
+		return
+	}
+	if o.X == nil {
+		return nil, chk.Err("NODE_COORD_SECTION is missing in TSPLIB file %q", filename)
+	}
+	var dist func(i, j int) float64
+	switch o.WeightType {
+	case "EUC_2D":
+		dist = o.distEuc2d
+	case "CEIL_2D":
+		dist = o.distCeil2d
+	case "GEO":
+		dist = o.distGeo
+	case "ATT":
+		dist = o.distAtt
+	default:
+		return nil, chk.Err("EDGE_WEIGHT_TYPE %q is not available", o.WeightType)
+	}
+	for i := 0; i < o.Dim; i++ {
+		for j := i + 1; j < o.Dim; j++ {
+			o.D[i][j] = dist(i, j)
+			o.D[j][i] = o.D[i][j]
+		}
+	}
+	return
+}
+
+// fillExplicit fills distance matrix with explicit weights
+func (o *Instance) fillExplicit(w []float64) (err error) {
+	n := o.Dim
+	var nw int
+	switch o.WeightFmt {
+	case "FULL_MATRIX":
+		nw = n * n
+	case "UPPER_ROW", "LOWER_ROW":
+		nw = n * (n - 1) / 2
+	case "UPPER_DIAG_ROW", "LOWER_DIAG_ROW":
+		nw = n * (n + 1) / 2
+	default:
+		return chk.Err("EDGE_WEIGHT_FORMAT %q is not available", o.WeightFmt)
+	}
+	if len(w) != nw {
+		return chk.Err("number of weights in EDGE_WEIGHT_SECTION is incorrect. %d != %d", len(w), nw)
+	}
+	k := 0
+	set := func(i, j int) {
+		o.D[i][j], o.D[j][i] = w[k], w[k]
+		k++
+	}
+	for i := 0; i < n; i++ {
+		switch o.WeightFmt {
+		case "FULL_MATRIX":
+			for j := 0; j < n; j++ {
+				o.D[i][j] = w[k]
+				k++
+			}
+		case "UPPER_ROW":
+			for j := i + 1; j < n; j++ {
+				set(i, j)
+			}
+		case "LOWER_ROW":
+			for j := 0; j < i; j++ {
+				set(i, j)
+			}
+		case "UPPER_DIAG_ROW":
+			for j := i; j < n; j++ {
+				set(i, j)
+			}
+		case "LOWER_DIAG_ROW":
+			for j := 0; j <= i; j++ {
+				set(i, j)
+			}
+		}
+	}
+	return
+}
+
+// nint returns the nearest integer
+func nint(x float64) float64 {
+	return math.Floor(x + 0.5)
+}
+
+// distEuc2d computes the Euclidean distance rounded to the nearest integer
+func (o *Instance) distEuc2d(i, j int) float64 {
+	return nint(math.Hypot(o.X[i]-o.X[j], o.Y[i]-o.Y[j]))
+}
+
+// distCeil2d computes the Euclidean distance rounded up to the next integer
+func (o *Instance) distCeil2d(i, j int) float64 {
+	return math.Ceil(math.Hypot(o.X[i]-o.X[j], o.Y[i]-o.Y[j]))
+}
+
+// distGeo computes the geographical distance; X = latitude, Y = longitude in DDD.MM format
+func (o *Instance) distGeo(i, j int) float64 {
+	rad := func(x float64) float64 {
+		deg := math.Trunc(x)
+		min := x - deg
+		return 3.141592 * (deg + 5.0*min/3.0) / 180.0
+	}
+	lati, loni := rad(o.X[i]), rad(o.Y[i])
+	latj, lonj := rad(o.X[j]), rad(o.Y[j])
+	q1 := math.Cos(loni - lonj)
+	q2 := math.Cos(lati - latj)
+	q3 := math.Cos(lati + latj)
+	return math.Trunc(6378.388*math.Acos(0.5*((1.0+q1)*q2-(1.0-q1)*q3)) + 1.0)
+}
+
+// distAtt computes the pseudo-Euclidean distance
+func (o *Instance) distAtt(i, j int) float64 {
+	xd, yd := o.X[i]-o.X[j], o.Y[i]-o.Y[j]
+	r := math.Sqrt((xd*xd + yd*yd) / 10.0)
+	t := nint(r)
+	if t < r {
+		return t + 1
+	}
+	return t
+}
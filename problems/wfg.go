@@ -0,0 +1,309 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// WFG returns the k-th scalable problem of the Walking Fish Group (WFG) toolkit
+//  idx  -- index of problem: 1 ≤ idx ≤ 9
+//  nf   -- number of objectives (M ≥ 2)
+//  kpos -- number of position parameters; must be a multiple of M-1
+//  lpos -- number of distance parameters; must be even for WFG2 and WFG3
+//  Note: the variables are zi ∈ [0, 2(i+1)] for i = 0...kpos+lpos-1. The functions are
+//        ported from the C++ implementation of the toolkit (ExampleProblems.cpp)
+//  Reference: Huband S, Hingston P, Barone L, While L (2006) A review of multiobjective test
+//             problems and a scalable test problem toolkit. IEEE Transactions on Evolutionary
+//             Computation, 10(5):477-506
+func WFG(idx, nf, kpos, lpos int) (o *Problem) {
+	if idx < 1 || idx > 9 {
+		chk.Panic("index of WFG problem must be in [1,9]. idx=%d is invalid", idx)
+	}
+	if nf < 2 {
+		chk.Panic("WFG problems require at least 2 objectives. nf=%d is invalid", nf)
+	}
+	if kpos < 1 || kpos%(nf-1) != 0 {
+		chk.Panic("number of position parameters of WFG problems must be a positive multiple of nf-1=%d. kpos=%d is invalid", nf-1, kpos)
+	}
+	if lpos < 1 || ((idx == 2 || idx == 3) && lpos%2 != 0) {
+		chk.Panic("number of distance parameters of WFG%d must be positive (and even for WFG2 and WFG3). lpos=%d is invalid", idx, lpos)
+	}
+	n := kpos + lpos
+	o = &Problem{Name: io.Sf("WFG%d", idx), Nf: nf}
+	o.Xmin, o.Xmax = make([]float64, n), make([]float64, n)
+	for i := 0; i < n; i++ {
+		o.Xmax[i] = 2 * float64(i+1)
+	}
+	o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+		copy(f, wfgEval(idx, nf, kpos, x))
+	}
+
+	// Pareto front: shape functions with underlying distance parameter equal to zero
+	//  Note: WFG3 is degenerate; i.e. the front is a line
+	o.Front = func(npts int) [][]float64 {
+		m := nf - 1
+		if idx == 3 {
+			m = 1
+		}
+		var F [][]float64
+		for _, p := range samplePositions(m, npts) {
+			x := make([]float64, nf)
+			for i := 0; i < nf-1; i++ {
+				x[i] = 0.5
+				if i < m {
+					x[i] = p[i]
+				}
+			}
+			F = append(F, wfgShapes(idx, x))
+		}
+		return nonDominated(F)
+	}
+	return
+}
+
+// wfgEval computes the objective values of WFG problems
+func wfgEval(idx, M, k int, z []float64) []float64 {
+
+	// normalise
+	n := len(z)
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		y[i] = z[i] / (2 * float64(i+1))
+	}
+
+	// transitions
+	switch idx {
+	case 1:
+		for i := k; i < n; i++ {
+			y[i] = wfgSlinear(y[i], 0.35)
+		}
+		for i := k; i < n; i++ {
+			y[i] = wfgBflat(y[i], 0.8, 0.75, 0.85)
+		}
+		for i := 0; i < n; i++ {
+			y[i] = wfgFix(math.Pow(y[i], 0.02))
+		}
+		w := make([]float64, n)
+		for i := 0; i < n; i++ {
+			w[i] = 2 * float64(i+1)
+		}
+		y = wfgReduceSum(y, w, k, M)
+	case 2, 3:
+		for i := k; i < n; i++ {
+			y[i] = wfgSlinear(y[i], 0.35)
+		}
+		l := n - k
+		t := y[:k:k]
+		for i := 0; i < l/2; i++ {
+			t = append(t, wfgRnonsep(y[k+2*i:k+2*i+2], 2))
+		}
+		y = wfgReduceSum(t, nil, k, M)
+	case 4:
+		for i := 0; i < n; i++ {
+			y[i] = wfgSmulti(y[i], 30, 10, 0.35)
+		}
+		y = wfgReduceSum(y, nil, k, M)
+	case 5:
+		for i := 0; i < n; i++ {
+			y[i] = wfgSdecept(y[i], 0.35, 0.001, 0.05)
+		}
+		y = wfgReduceSum(y, nil, k, M)
+	case 6:
+		for i := k; i < n; i++ {
+			y[i] = wfgSlinear(y[i], 0.35)
+		}
+		y = wfgReduceNonsep(y, k, M)
+	case 7:
+		t := make([]float64, n)
+		copy(t, y)
+		for i := 0; i < k; i++ {
+			t[i] = wfgBparam(y[i], wfgRsum(y[i+1:], nil), 0.98/49.98, 0.02, 50)
+		}
+		for i := k; i < n; i++ {
+			t[i] = wfgSlinear(t[i], 0.35)
+		}
+		y = wfgReduceSum(t, nil, k, M)
+	case 8:
+		t := make([]float64, n)
+		copy(t, y)
+		for i := k; i < n; i++ {
+			t[i] = wfgBparam(y[i], wfgRsum(y[:i], nil), 0.98/49.98, 0.02, 50)
+		}
+		for i := k; i < n; i++ {
+			t[i] = wfgSlinear(t[i], 0.35)
+		}
+		y = wfgReduceSum(t, nil, k, M)
+	case 9:
+		t := make([]float64, n)
+		copy(t, y)
+		for i := 0; i < n-1; i++ {
+			t[i] = wfgBparam(y[i], wfgRsum(y[i+1:], nil), 0.98/49.98, 0.02, 50)
+		}
+		for i := 0; i < k; i++ {
+			t[i] = wfgSdecept(t[i], 0.35, 0.001, 0.05)
+		}
+		for i := k; i < n; i++ {
+			t[i] = wfgSmulti(t[i], 30, 95, 0.35)
+		}
+		y = wfgReduceNonsep(t, k, M)
+	}
+
+	// underlying parameters
+	x := make([]float64, M)
+	for i := 0; i < M-1; i++ {
+		a := 1.0
+		if idx == 3 && i > 0 {
+			a = 0 // degenerate
+		}
+		x[i] = math.Max(y[M-1], a)*(y[i]-0.5) + 0.5
+	}
+	x[M-1] = y[M-1]
+
+	return wfgShapes(idx, x)
+}
+
+// wfgShapes computes the objective values from the underlying parameters x
+func wfgShapes(idx int, x []float64) []float64 {
+	M := len(x)
+	f := make([]float64, M)
+	for m := 1; m <= M; m++ {
+		var hm float64
+		switch {
+		case idx == 1 && m == M:
+			hm = wfgFix(1 - x[0] - math.Cos(10*math.Pi*x[0]+math.Pi/2)/(10*math.Pi))
+		case idx == 2 && m == M:
+			hm = wfgFix(1 - x[0]*math.Pow(math.Cos(5*x[0]*math.Pi), 2))
+		case idx == 1 || idx == 2:
+			hm = wfgShape(x, m, func(v float64) float64 { return 1 - math.Cos(v*math.Pi/2) }, func(v float64) float64 { return 1 - math.Sin(v*math.Pi/2) })
+		case idx == 3:
+			hm = wfgShape(x, m, func(v float64) float64 { return v }, func(v float64) float64 { return 1 - v })
+		default:
+			hm = wfgShape(x, m, func(v float64) float64 { return math.Sin(v * math.Pi / 2) }, func(v float64) float64 { return math.Cos(v * math.Pi / 2) })
+		}
+		f[m-1] = x[M-1] + 2*float64(m)*hm
+	}
+	return f
+}
+
+// wfgShape computes the m-th shape function (1 ≤ m ≤ M) given the functions of the product and
+// of the last term (linear, convex and concave shapes)
+func wfgShape(x []float64, m int, prod, last func(v float64) float64) float64 {
+	M := len(x)
+	res := 1.0
+	for i := 0; i < M-m; i++ {
+		res *= prod(x[i])
+	}
+	if m != 1 {
+		res *= last(x[M-m])
+	}
+	return wfgFix(res)
+}
+
+// wfgReduceSum reduces y to M values using weighted sums of position and distance parameters.
+// w == nil means unit weights
+func wfgReduceSum(y, w []float64, k, M int) []float64 {
+	n := len(y)
+	t := make([]float64, M)
+	sub := func(a, b int) []float64 {
+		if w == nil {
+			return nil
+		}
+		return w[a:b]
+	}
+	for i := 1; i < M; i++ {
+		a, b := (i-1)*k/(M-1), i*k/(M-1)
+		t[i-1] = wfgRsum(y[a:b], sub(a, b))
+	}
+	t[M-1] = wfgRsum(y[k:n], sub(k, n))
+	return t
+}
+
+// wfgReduceNonsep reduces y to M values using the non-separable reduction
+func wfgReduceNonsep(y []float64, k, M int) []float64 {
+	n := len(y)
+	t := make([]float64, M)
+	for i := 1; i < M; i++ {
+		t[i-1] = wfgRnonsep(y[(i-1)*k/(M-1):i*k/(M-1)], k/(M-1))
+	}
+	t[M-1] = wfgRnonsep(y[k:n], n-k)
+	return t
+}
+
+// wfgFix corrects values slightly outside [0,1] due to round-off errors
+func wfgFix(a float64) float64 {
+	const ϵ = 1e-10
+	switch {
+	case a <= 0 && a >= -ϵ:
+		return 0
+	case a >= 1 && a <= 1+ϵ:
+		return 1
+	}
+	return a
+}
+
+// wfgSlinear implements the linear shift transformation
+func wfgSlinear(y, A float64) float64 {
+	return wfgFix(math.Abs(y-A) / math.Abs(math.Floor(A-y)+A))
+}
+
+// wfgBflat implements the flat region bias transformation
+func wfgBflat(y, A, B, C float64) float64 {
+	t1 := math.Min(0, math.Floor(y-B)) * A * (B - y) / B
+	t2 := math.Min(0, math.Floor(C-y)) * (1 - A) * (y - C) / (1 - C)
+	return wfgFix(A + t1 - t2)
+}
+
+// wfgBparam implements the parameter dependent bias transformation
+func wfgBparam(y, u, A, B, C float64) float64 {
+	v := A - (1-2*u)*math.Abs(math.Floor(0.5-u)+A)
+	return wfgFix(math.Pow(y, B+(C-B)*v))
+}
+
+// wfgSdecept implements the deceptive shift transformation
+func wfgSdecept(y, A, B, C float64) float64 {
+	t1 := math.Floor(y-A+B) * (1 - C + (A-B)/B) / (A - B)
+	t2 := math.Floor(A+B-y) * (1 - C + (1-A-B)/B) / (1 - A - B)
+	return wfgFix(1 + (math.Abs(y-A)-B)*(t1+t2+1/B))
+}
+
+// wfgSmulti implements the multi-modal shift transformation
+func wfgSmulti(y float64, A int, B, C float64) float64 {
+	t1 := math.Abs(y-C) / (2 * (math.Floor(C-y) + C))
+	t2 := (4*float64(A) + 2) * math.Pi * (0.5 - t1)
+	return wfgFix((1 + math.Cos(t2) + 4*B*t1*t1) / (B + 2))
+}
+
+// wfgRsum implements the weighted sum reduction. w == nil means unit weights
+func wfgRsum(y, w []float64) float64 {
+	num, den := 0.0, 0.0
+	for i, v := range y {
+		wi := 1.0
+		if w != nil {
+			wi = w[i]
+		}
+		num += wi * v
+		den += wi
+	}
+	return wfgFix(num / den)
+}
+
+// wfgRnonsep implements the non-separable reduction
+func wfgRnonsep(y []float64, A int) float64 {
+	n := len(y)
+	num := 0.0
+	for j := 0; j < n; j++ {
+		num += y[j]
+		for k := 0; k <= A-2; k++ {
+			num += math.Abs(y[j] - y[(j+k+1)%n])
+		}
+	}
+	tmp := math.Ceil(float64(A) / 2)
+	den := float64(n) * tmp * (1 + 2*float64(A) - 2*tmp) / float64(A)
+	return wfgFix(num / den)
+}
@@ -0,0 +1,116 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package problems
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// ZDT returns the k-th problem of Zitzler, Deb and Thiele (2000) with two objectives
+//  k -- index of problem: 1 ≤ k ≤ 6
+//  n -- number of floats. 0 means 30 (ZDT1-3) or 10 (ZDT4 and ZDT6). ZDT5 uses n binary
+//       substrings: one with 30 bits and n-1 with 5 bits; 0 means n = 11 (80 bits)
+//  Reference: Deb K (2001) Multi-Objective Optimization using Evolutionary Algorithms. Wiley
+func ZDT(k, n int) (o *Problem) {
+	if k < 1 || k > 6 {
+		chk.Panic("index of ZDT problem must be in [1,6]. k=%d is invalid", k)
+	}
+	if n == 0 {
+		switch k {
+		case 4, 6:
+			n = 10
+		case 5:
+			n = 11
+		default:
+			n = 30
+		}
+	}
+	if n < 2 {
+		chk.Panic("ZDT problems require at least 2 variables. n=%d is invalid", n)
+	}
+	o = &Problem{Name: io.Sf("ZDT%d", k), Nf: 2}
+	if k == 5 {
+		o.BinInt = 30 + 5*(n-1)
+		o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+			ones := func(bits []int) (u int) {
+				for _, b := range bits {
+					u += b
+				}
+				return
+			}
+			f[0] = 1 + float64(ones(ξ[:30]))
+			sum := 0.0
+			for i := 30; i < len(ξ); i += 5 {
+				u := ones(ξ[i : i+5])
+				if u < 5 {
+					sum += float64(2 + u)
+				} else {
+					sum += 1
+				}
+			}
+			f[1] = sum / f[0]
+		}
+		o.Front = func(npts int) (F [][]float64) {
+			for u := 0; u <= 30; u++ {
+				F = append(F, []float64{1 + float64(u), float64(n-1) / (1 + float64(u))})
+			}
+			return
+		}
+		return
+	}
+
+	// floats
+	o.Xmin, o.Xmax = bounds(n, n, 0, 1)
+	if k == 4 {
+		o.Xmin, o.Xmax = bounds(n, 1, -5, 5)
+	}
+	o.Fcn = func(f, g, h, x []float64, ξ []int, cpu int) {
+		sum := 0.0
+		switch k {
+		case 4:
+			for i := 1; i < n; i++ {
+				sum += x[i]*x[i] - 10*math.Cos(4*math.Pi*x[i])
+			}
+			sum = 1 + 10*float64(n-1) + sum
+		case 6:
+			for i := 1; i < n; i++ {
+				sum += x[i]
+			}
+			sum = 1 + 9*math.Pow(sum/float64(n-1), 0.25)
+		default:
+			for i := 1; i < n; i++ {
+				sum += x[i]
+			}
+			sum = 1 + 9*sum/float64(n-1)
+		}
+		c := sum
+		f[0] = x[0]
+		if k == 6 {
+			f[0] = 1 - math.Exp(-4*x[0])*math.Pow(math.Sin(6*math.Pi*x[0]), 6)
+		}
+		switch k {
+		case 2, 6:
+			f[1] = c * (1 - math.Pow(f[0]/c, 2))
+		case 3:
+			f[1] = c * (1 - math.Sqrt(f[0]/c) - f[0]/c*math.Sin(10*math.Pi*f[0]))
+		default:
+			f[1] = c * (1 - math.Sqrt(f[0]/c))
+		}
+	}
+
+	// Pareto optimal set: x0 ∈ [0,1] and x1 = ... = x(n-1) = 0
+	o.Front = func(npts int) [][]float64 {
+		return frontFromSet(o.Fcn, 2, n, 1, npts, func(x, p []float64) {
+			x[0] = p[0]
+			for i := 1; i < n; i++ {
+				x[i] = 0
+			}
+		})
+	}
+	return
+}
@@ -6,6 +6,8 @@ package goga
 
 import (
 	"bytes"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cpmech/gosl/chk"
@@ -40,6 +42,10 @@ type TexReport struct {
 	ShowDEC      bool   // show DE coefficient
 	ShowX01      bool   // show x[0] and x[1] in table
 
+	// comparison
+	Versus []*Optimiser // optimisers compared with Opts row by row (rank-sum test); e.g. with other parameters. nil means no comparison
+	Alpha  float64      // significance level of comparisons with Versus
+
 	// constants
 	DroundCte time.Duration // constant for dround(duration) function
 
@@ -55,6 +61,7 @@ type TexReport struct {
 	binp      *bytes.Buffer // buffer for input data
 	bxres     *bytes.Buffer // buffer for x results
 	singleObj bool
+	mark      string // significance marker of current row (math mode)
 }
 
 // SetDefault sets default options for report
@@ -81,6 +88,7 @@ func NewTexReport(opts []*Optimiser) (o *TexReport) {
 	o.ShowDtExc = false
 	o.ShowDEC = false
 	o.ShowX01 = true
+	o.Alpha = 0.05
 
 	// constants
 	o.DroundCte = 0.001e9 // 0.0001e9
@@ -224,7 +232,7 @@ func (o *TexReport) compactTableHeader(contd string) {
 	}
 	io.Ff(o.buf, `
 \begin{table*} [!t] \centering
-\caption{%s: results ($N_{samples}=%d$).}
+\caption{%s: results ($N_{samples}=%d$)%s.}
 %s
 
 \begin{tabular}[c]{%s} \toprule
@@ -234,7 +242,7 @@ P
 $%s_{min}$ & $%s_{ave}$ & $%s_{max}$ & $%s_{dev}$ 
 %s
 \\ \hline
-`, o.Title+contd, o.nsamples, o.TextSize, txtCols,
+`, o.Title+contd, o.nsamples, o.legend(), o.TextSize, txtCols,
 		txtNsol, txtNcpu, txtTmax, txtDtExc,
 		txtDEC,
 		o.symbF, o.symbF, o.symbF, o.symbF, o.symbF,
@@ -250,7 +258,7 @@ func (o *TexReport) normalTableHeader(contd string) {
 
 \begin{tabular}[c]{ccccc} \toprule
 P & settings & settings/info & %s & %s \\ \hline
-`, o.Title+contd, o.TextSize, o.col4, o.col5)
+`, o.Title+contd+o.legend(), o.TextSize, o.col4, o.col5)
 }
 
 // tableFooter add table footer
@@ -332,6 +340,7 @@ func (o *TexReport) Generate() {
 				io.Ff(o.buf, "\n")
 			}
 		}
+		o.mark = o.marker(i, opt)
 		addRow(opt)
 		o.inputRow(opt)
 		o.xResRow(opt)
@@ -396,6 +405,9 @@ func (o *TexReport) oneCompactAddRow(opt *Optimiser) {
 	}
 	Fmin, Fave, Fmax, Fdev, _ := StatF(opt, 0, false)
 	FminTxt, FaveTxt, FmaxTxt, FdevTxt := tx(opt.RptFmtF, Fmin), tx(opt.RptFmtF, Fave), tx(opt.RptFmtF, Fmax), tx(opt.RptFmtFdev, Fdev)
+	if o.mark != "" {
+		FaveTxt += "$" + o.mark + "$"
+	}
 	txtNsol := ""
 	if o.ShowNsol {
 		txtNsol = io.Sf("& %d", opt.Nsol)
@@ -480,7 +492,7 @@ func (o *TexReport) oneNormalAddRow(opt *Optimiser) {
 		opt.RptName,
 		opt.Nsol, opt.Ncpu, opt.Tf, opt.DtExc,
 		opt.RptWordF, FrefTxt, opt.DEC, opt.Nfeval, dround(opt.SysTimeAve, o.DroundCte),
-		opt.RptWordF, FminTxt, opt.RptWordF, FaveTxt, opt.RptWordF, FmaxTxt, opt.RptWordF, FdevTxt,
+		opt.RptWordF, FminTxt, opt.RptWordF, FaveTxt+o.mark, opt.RptWordF, FmaxTxt, opt.RptWordF, FdevTxt,
 		o.MiniPageSz, o.HistTextSize, hist)
 	io.Ff(o.buf, "\n")
 }
@@ -527,7 +539,7 @@ func (o *TexReport) twoAddRow(opt *Optimiser) {
 		opt.RptName,
 		opt.Nsol, opt.Ncpu, opt.Tf, opt.DtExc,
 		len(E), opt.DEC, opt.Nfeval, dround(opt.SysTimeAve, o.DroundCte),
-		EminTxt, EaveTxt, EmaxTxt, EdevTxt,
+		EminTxt, EaveTxt+o.mark, EmaxTxt, EdevTxt,
 		LminTxt, LaveTxt, LmaxTxt, LdevTxt)
 }
 
@@ -571,49 +583,235 @@ func (o *TexReport) multiAddRow(opt *Optimiser) {
 		opt.RptName,
 		opt.Nsol, opt.Ncpu, opt.Tf, opt.DtExc,
 		opt.Nova, opt.DEC, opt.Nfeval, dround(opt.SysTimeAve, o.DroundCte),
-		Ekey, EminTxt, Ekey, EaveTxt, Ekey, EmaxTxt, Ekey, EdevTxt,
+		Ekey, EminTxt, Ekey, EaveTxt+o.mark, Ekey, EmaxTxt, Ekey, EdevTxt,
 		o.MiniPageSz, o.HistTextSize, hist)
 }
 
+// comparisons ///////////////////////////////////////////////////////////////////////////////////////
+
+// marker returns the significance marker (math mode) of the comparison of the i-th row with Versus
+func (o *TexReport) marker(i int, opt *Optimiser) string {
+	if len(o.Versus) == 0 {
+		return ""
+	}
+	if len(o.Versus) != len(o.Opts) {
+		chk.Panic("Versus must have the same number of optimisers as Opts. %d != %d", len(o.Versus), len(o.Opts))
+	}
+	key := "f0"
+	switch o.Type {
+	case 2:
+		key = "F1F0"
+	case 3:
+		key = "E"
+		if len(opt.Multi_err) < 2 {
+			key = "IGD"
+		}
+	}
+	switch CompareOpts(opt, o.Versus[i], key, o.Alpha, false).Sign {
+	case 1:
+		return "^{+}"
+	case -1:
+		return "^{-}"
+	}
+	return "^{\\approx}"
+}
+
+// legend returns the explanation of significance markers for captions
+func (o *TexReport) legend() string {
+	if len(o.Versus) == 0 {
+		return ""
+	}
+	return io.Sf(". Average values marked with $^{+}$ ($^{-}$) are significantly better (worse) than "+
+		"the compared results by the rank-sum test with $\\alpha=%g$; $^{\\approx}$ means no significant difference", o.Alpha)
+}
+
 // other reporting functions ///////////////////////////////////////////////////////////////////////
 
+// WriteAllValues writes all values of solutions to a ".res" file
+//  Note: labels are written for categorical ints; labels with spaces (or quotes) are quoted.
+//        With variable-length chromosomes (VarLen), the number of genes of each solution is
+//        written in column "ngene" and missing genes (up to LenMax) are written as "-"
 func WriteAllValues(dirout, fnkey string, opt *Optimiser) {
+	nflt, nint := opt.Nflt, opt.Nint
+	if opt.VarLen {
+		nflt, nint = opt.LenMax*opt.Nflt, opt.LenMax*opt.Nint
+	}
 	var buf bytes.Buffer
 	io.Ff(&buf, "%5s", "front")
+	if opt.VarLen {
+		io.Ff(&buf, "%6s", "ngene")
+	}
 	for i := 0; i < opt.Nova; i++ {
 		io.Ff(&buf, "%24s", io.Sf("f%d", i))
 	}
 	for i := 0; i < opt.Noor; i++ {
 		io.Ff(&buf, "%24s", io.Sf("u%d", i))
 	}
-	for i := 0; i < opt.Nflt; i++ {
+	for i := 0; i < nflt; i++ {
 		io.Ff(&buf, "%24s", io.Sf("x%d", i))
 	}
-	for i := 0; i < opt.Nint; i++ {
+	for i := 0; i < nint; i++ {
 		io.Ff(&buf, "%24s", io.Sf("y%d", i))
 	}
 	io.Ff(&buf, "\n")
 	for _, sol := range opt.Solutions {
 		io.Ff(&buf, "%5d", sol.FrontId)
+		if opt.VarLen {
+			io.Ff(&buf, "%6d", sol.Ngenes())
+		}
 		for i := 0; i < opt.Nova; i++ {
 			io.Ff(&buf, "%24g", sol.Ova[i])
 		}
 		for i := 0; i < opt.Noor; i++ {
 			io.Ff(&buf, "%24g", sol.Oor[i])
 		}
-		for i := 0; i < opt.Nflt; i++ {
+		for i := 0; i < nflt; i++ {
+			if i >= len(sol.Flt) {
+				io.Ff(&buf, "%24s", "-")
+				continue
+			}
 			io.Ff(&buf, "%24g", sol.Flt[i])
 		}
-		for i := 0; i < opt.Nint; i++ {
-			io.Ff(&buf, "%24g", sol.Int[i])
+		for i := 0; i < nint; i++ {
+			if i >= len(sol.Int) {
+				io.Ff(&buf, "%24s", "-")
+				continue
+			}
+			label := opt.IntLabel(i%opt.Nint, sol.Int[i])
+			if label == "" || strings.ContainsAny(label, " \t\"") {
+				label = strconv.Quote(label)
+			}
+			io.Ff(&buf, "%24s", label)
 		}
 		io.Ff(&buf, "\n")
 	}
 	io.WriteFileVD(dirout, fnkey+".res", &buf)
 }
 
+// ReadAllValues reads floats and ints of solutions from a ".res" file written by WriteAllValues
+//  Output:
+//   flt  -- [nsol][ngene*nflt] floats. nil if there are no floats
+//   ints -- [nsol][ngene*nint] ints. nil if there are no ints. labels of categorical ints are converted
+//  Note: ngene = 1 if VarLen == false; otherwise it is read from column "ngene".
+//        Errors report the line and column (starting at 1) of invalid values
+func ReadAllValues(filename string, prms *Parameters) (flt [][]float64, ints [][]int, err error) {
+
+	// read file
+	b, err := io.ReadFile(filename)
+	if err != nil {
+		return nil, nil, chk.Err("cannot read results file %q:\n%v", filename, err)
+	}
+	lines := strings.Split(string(b), "\n")
+
+	// header: front [ngene] f0 f1 ... u0 u1 ... x0 x1 ... y0 y1 ...
+	var cflt, cint []int // columns of floats and ints
+	cgen := -1           // column of number of genes
+	header := strings.Fields(lines[0])
+	for j, key := range header {
+		switch {
+		case key == "ngene":
+			cgen = j
+		case key[0] == 'x':
+			cflt = append(cflt, j)
+		case key[0] == 'y':
+			cint = append(cint, j)
+		}
+	}
+	nflt, nint := prms.Nflt, prms.Nint
+	if prms.VarLen {
+		nflt, nint = prms.LenMax*prms.Nflt, prms.LenMax*prms.Nint
+	}
+	if prms.VarLen != (cgen >= 0) {
+		return nil, nil, chk.Err("results file %q must have column \"ngene\" if and only if VarLen is true. VarLen = %v", filename, prms.VarLen)
+	}
+	if len(cflt) != nflt || len(cint) != nint {
+		return nil, nil, chk.Err("numbers of floats and ints in results file %q are incorrect. nflt: %d != %d, nint: %d != %d", filename, len(cflt), nflt, len(cint), nint)
+	}
+
+	// values
+	for l, line := range lines[1:] {
+		fields, err := splitFields(line)
+		if err != nil {
+			return nil, nil, chk.Err("line %d of results file %q is invalid:\n%v", l+2, filename, err)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != len(header) {
+			return nil, nil, chk.Err("line %d of results file %q has %d columns instead of %d", l+2, filename, len(fields), len(header))
+		}
+		ngene := 1
+		if prms.VarLen {
+			ngene, err = strconv.Atoi(fields[cgen])
+			if err != nil || ngene < prms.LenMin || ngene > prms.LenMax {
+				return nil, nil, chk.Err("line %d, column %d of results file %q: number of genes %q is invalid. it must be an int in [%d,%d]", l+2, cgen+1, filename, fields[cgen], prms.LenMin, prms.LenMax)
+			}
+		}
+		if prms.Nflt > 0 {
+			x := make([]float64, ngene*prms.Nflt)
+			for i := range x {
+				j := cflt[i]
+				x[i], err = strconv.ParseFloat(fields[j], 64)
+				if err != nil {
+					return nil, nil, chk.Err("line %d, column %d of results file %q: cannot parse float %q", l+2, j+1, filename, fields[j])
+				}
+			}
+			flt = append(flt, x)
+		}
+		if prms.Nint > 0 {
+			y := make([]int, ngene*prms.Nint)
+			for i := range y {
+				j, k := cint[i], i%prms.Nint
+				if prms.IsCat(k) {
+					y[i] = -1
+					for m, label := range prms.IntCats[k] {
+						if label == fields[j] {
+							y[i] = m
+						}
+					}
+					if y[i] < 0 {
+						return nil, nil, chk.Err("line %d, column %d of results file %q: label %q of categorical int %d is unknown", l+2, j+1, filename, fields[j], k)
+					}
+					continue
+				}
+				y[i], err = strconv.Atoi(fields[j])
+				if err != nil {
+					return nil, nil, chk.Err("line %d, column %d of results file %q: cannot parse int %q", l+2, j+1, filename, fields[j])
+				}
+			}
+			ints = append(ints, y)
+		}
+	}
+	return
+}
+
 // auxiliary ///////////////////////////////////////////////////////////////////////////////////////
 
+// splitFields splits line into fields separated by white spaces. quoted fields (written by
+// WriteAllValues for labels with spaces) are unquoted
+func splitFields(line string) (fields []string, err error) {
+	for {
+		line = strings.TrimLeft(line, " \t\r")
+		if line == "" {
+			return
+		}
+		if line[0] != '"' {
+			n := strings.IndexAny(line, " \t\r")
+			if n < 0 {
+				n = len(line)
+			}
+			fields, line = append(fields, line[:n]), line[n:]
+			continue
+		}
+		quoted, e := strconv.QuotedPrefix(line)
+		if e != nil {
+			return nil, chk.Err("quoted field %s is not terminated", line)
+		}
+		field, _ := strconv.Unquote(quoted)
+		fields, line = append(fields, field), line[len(quoted):]
+	}
+}
+
 func tx(fmt string, num float64) string {
 	return io.TexNum(fmt, num, true)
 }
@@ -0,0 +1,312 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/rnd"
+)
+
+// sobolM holds initial direction numbers m1, m2, ... of the first dimensions of Sobol sequences;
+// after Joe and Kuo (see SobolPoints). The corresponding primitive polynomials are computed by
+// sobolPolys. Dimension 0 (van der Corput sequence) is not included
+var sobolM = [][]uint32{
+	{1},
+	{1, 3},
+	{1, 3, 1},
+	{1, 1, 1},
+	{1, 1, 3, 3},
+	{1, 3, 5, 13},
+	{1, 1, 5, 5, 17},
+	{1, 1, 5, 5, 5},
+	{1, 1, 7, 11, 19},
+	{1, 1, 5, 1, 1},
+	{1, 1, 1, 3, 11},
+	{1, 3, 5, 5, 31},
+	{1, 3, 3, 9, 7, 49},
+	{1, 1, 1, 15, 21, 21},
+	{1, 3, 1, 13, 27, 49},
+	{1, 1, 1, 15, 7, 5},
+	{1, 3, 1, 15, 13, 25},
+	{1, 1, 5, 5, 19, 61},
+	{1, 3, 7, 11, 23, 15, 103},
+	{1, 3, 7, 13, 13, 15, 69},
+}
+
+// SobolPoints generates n points of a Sobol low-discrepancy sequence in [0,1)^dim
+//  scramble -- apply random linear matrix scrambling and digital shift
+//  Output: X -- [dim][n] coordinates; i.e. the same layout as rnd.HaltonPoints
+//  Note: for dimensions beyond the table of direction numbers, initial direction numbers are
+//        randomly selected odd numbers; i.e. a valid but not optimised choice
+//  References:
+//   [1] Sobol IM. On the distribution of points in a cube and the approximate evaluation of
+//       integrals. USSR Computational Mathematics and Mathematical Physics, 7(4):86-112; 1967
+//   [2] Joe S and Kuo FY. Constructing Sobol sequences with better two-dimensional projections.
+//       SIAM Journal on Scientific Computing, 30(5):2635-2654; 2008
+//   [3] Matoušek J. On the L2-discrepancy for anchored boxes. Journal of Complexity,
+//       14(4):527-556; 1998 (random linear scrambling)
+func SobolPoints(dim, n int, scramble bool) (X [][]float64) {
+	const nbits = 32
+	polys := sobolPolys(dim - 1)
+	X = make([][]float64, dim)
+	v := make([]uint32, nbits+1)
+	for j := 0; j < dim; j++ {
+
+		// direction numbers v[k] = m[k] / 2^k (k = 1...nbits)
+		if j == 0 {
+			for k := 1; k <= nbits; k++ {
+				v[k] = 1 << uint(nbits-k)
+			}
+		} else {
+			s, a := polys[j-1][0], polys[j-1][1]
+			for k := 1; k <= s && k <= nbits; k++ {
+				var m uint32
+				if j-1 < len(sobolM) {
+					m = sobolM[j-1][k-1]
+				} else {
+					m = uint32(2*rnd.Int(0, (1<<uint(k-1))-1) + 1) // odd and < 2^k
+				}
+				v[k] = m << uint(nbits-k)
+			}
+			for k := s + 1; k <= nbits; k++ {
+				v[k] = v[k-s] ^ (v[k-s] >> uint(s))
+				for l := 1; l < s; l++ {
+					if (a>>uint(s-1-l))&1 == 1 {
+						v[k] ^= v[k-l]
+					}
+				}
+			}
+		}
+
+		// scrambling: v ← L v with random lower-triangular L (unit diagonal) and random shift
+		var shift uint32
+		if scramble {
+			var L [nbits]uint32 // L[i] holds row i; bit (nbits-1-l) corresponds to column l
+			for i := 0; i < nbits; i++ {
+				L[i] = 1 << uint(nbits-1-i)
+				for l := 0; l < i; l++ {
+					if rnd.FlipCoin(0.5) {
+						L[i] |= 1 << uint(nbits-1-l)
+					}
+				}
+				if rnd.FlipCoin(0.5) {
+					shift |= 1 << uint(nbits-1-i)
+				}
+			}
+			for k := 1; k <= nbits; k++ {
+				var w uint32
+				for i := 0; i < nbits; i++ {
+					if parity32(L[i]&v[k]) == 1 {
+						w |= 1 << uint(nbits-1-i)
+					}
+				}
+				v[k] = w
+			}
+		}
+
+		// points: Gray code ordering (Antonov and Saleev)
+		X[j] = make([]float64, n)
+		x := shift
+		for i := 0; i < n; i++ {
+			X[j][i] = float64(x) / math.Pow(2, nbits)
+			c := 1 // position of rightmost zero bit of i
+			for b := i; b&1 == 1; b >>= 1 {
+				c++
+			}
+			if c <= nbits {
+				x ^= v[c]
+			}
+		}
+	}
+	return
+}
+
+// LatinMaximin generates a maximin-optimised Latin hypercube
+//  niter -- number of trial swaps of two entries within one column
+//  Output: K -- [dim][n] values in {1,...,n}; i.e. the same layout as rnd.LatinIHS
+//  Note: starting from a random Latin hypercube, swaps are accepted if they reduce the φp
+//        criterion of Morris and Mitchell with p = 50, which approximates the maximisation of
+//        the minimum distance between points while being sensitive to all small distances
+//  Reference:
+//   [1] Morris MD and Mitchell TJ. Exploratory designs for computational experiments. Journal of
+//       Statistical Planning and Inference, 43(3):381-402; 1995
+func LatinMaximin(dim, n, niter int) (K [][]int) {
+
+	// random Latin hypercube
+	K = make([][]int, dim)
+	for j := 0; j < dim; j++ {
+		K[j] = make([]int, n)
+		GenPerm(K[j])
+		for i := 0; i < n; i++ {
+			K[j][i]++
+		}
+	}
+	if n < 3 {
+		return
+	}
+
+	// squared distances
+	const p = 50.0
+	D := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		D[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for k := i + 1; k < n; k++ {
+			for j := 0; j < dim; j++ {
+				δ := float64(K[j][i] - K[j][k])
+				D[i][k] += δ * δ
+			}
+			D[k][i] = D[i][k]
+		}
+	}
+	ϕ := func(d2 float64) float64 { return math.Pow(d2, -p/2.0) }
+
+	// swaps
+	d1, d2 := make([]float64, n), make([]float64, n)
+	for it := 0; it < niter; it++ {
+		j := rnd.Int(0, dim-1)
+		ij := rnd.IntGetUniqueN(0, n, 2)
+		i1, i2 := ij[0], ij[1]
+		Δ := 0.0
+		for k := 0; k < n; k++ {
+			if k == i1 || k == i2 {
+				continue
+			}
+			a1, a2 := float64(K[j][i1]-K[j][k]), float64(K[j][i2]-K[j][k])
+			d1[k] = D[i1][k] - a1*a1 + a2*a2
+			d2[k] = D[i2][k] - a2*a2 + a1*a1
+			Δ += ϕ(d1[k]) + ϕ(d2[k]) - ϕ(D[i1][k]) - ϕ(D[i2][k])
+		}
+		if Δ < 0 {
+			K[j][i1], K[j][i2] = K[j][i2], K[j][i1]
+			for k := 0; k < n; k++ {
+				if k == i1 || k == i2 {
+					continue
+				}
+				D[i1][k], D[k][i1] = d1[k], d1[k]
+				D[i2][k], D[k][i2] = d2[k], d2[k]
+			}
+		}
+	}
+	return
+}
+
+// opposition performs the opposition-based initialisation of sols: the opposite of each solution
+// is evaluated and the best half of the pool of solutions and opposites is kept
+//  Note: the opposite of x ∈ [xmin,xmax] is xmin + xmax - x. The same applies to (categorical or
+//        permutation) ints, whereas binary ints are flipped. Fixed and seed solutions are kept
+//  Output: number of function evaluations
+//  Reference:
+//   [1] Rahnamayan S, Tizhoosh HR and Salama MMA. Opposition-based differential evolution. IEEE
+//       Transactions on Evolutionary Computation, 12(1):64-79; 2008
+func (o *Optimiser) opposition(sols []*Solution, cpu int) (nfeval int) {
+
+	// candidates
+	var cand []*Solution
+	for _, sol := range sols {
+		if !sol.Fixed && !sol.Seed {
+			cand = append(cand, sol)
+		}
+	}
+	n := len(cand)
+	if n < 1 {
+		return
+	}
+
+	// pool with solutions and opposites
+	pool := make([]*Solution, 2*n)
+	for k, sol := range cand {
+		pool[k] = NewSolution(sol.Id, 2*n, &o.Parameters)
+		sol.CopyInto(pool[k])
+		opp := NewSolution(sol.Id, 2*n, &o.Parameters)
+		sol.CopyInto(opp)
+		for i, x := range sol.Flt {
+			j := i % o.Nflt
+			opp.Flt[i] = o.FltMin[j] + o.FltMax[j] - x
+		}
+		if !o.VarLen {
+			o.SnapFlt(opp.Flt)
+		}
+		switch {
+		case o.FltBits > 0:
+			o.EncodeFlt(opp.Int, opp.Flt)
+			o.DecodeFlt(opp.Flt, opp.Int)
+		case o.BinInt > 0:
+			for i, y := range sol.Int {
+				opp.Int[i] = 1 - y
+			}
+		default:
+			for i, y := range sol.Int {
+				j := i % o.Nint
+				opp.Int[i] = o.IntMin[j] + o.IntMax[j] - y
+			}
+		}
+		o.ObjFunc(opp, cpu)
+		nfeval++
+		pool[n+k] = opp
+	}
+
+	// select best half
+	switch {
+	case o.Lexico:
+		SortSolutions(pool, o.LexKeys()...)
+	case o.Nova < 2:
+		SortSolutions(pool, SortKey{Type: KeyOor}, SortKey{Type: KeyOva})
+	default:
+		var metrics Metrics
+		metrics.Init(2*n, &o.Parameters)
+		metrics.Compute(pool)
+		SortSolutions(pool, SortKey{Type: KeyFront}, SortKey{Type: KeyCrowd})
+	}
+	for k, sol := range cand {
+		pool[k].CopyInto(sol)
+	}
+	return
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// sobolPolys returns the first n primitive polynomials over GF(2), sorted by degree and value;
+// i.e. the polynomials of dimensions 1, 2, ... of Sobol sequences (x+1 is the first one)
+//  Output: [n][2] with degree s and coefficients a of x^{s-1}...x^1 (bit s-2 ... bit 0)
+func sobolPolys(n int) (res [][2]int) {
+	for s := 1; len(res) < n; s++ {
+		for q := 1<<uint(s) + 1; q < 1<<uint(s+1) && len(res) < n; q += 2 {
+			if isPrimitive(q, s) {
+				res = append(res, [2]int{s, (q >> 1) & (1<<uint(s-1) - 1)})
+			}
+		}
+	}
+	return
+}
+
+// isPrimitive tells whether polynomial q of degree s over GF(2) is primitive; i.e. the order of x
+// modulo q is 2^s-1
+func isPrimitive(q, s int) bool {
+	period := 1<<uint(s) - 1
+	r := 1
+	for k := 1; k <= period; k++ {
+		r <<= 1 // r = x^k mod q
+		if r&(1<<uint(s)) != 0 {
+			r ^= q
+		}
+		if r == 1 {
+			return k == period
+		}
+	}
+	return false
+}
+
+// parity32 returns the parity of the number of bits in x
+func parity32(x uint32) uint32 {
+	x ^= x >> 16
+	x ^= x >> 8
+	x ^= x >> 4
+	x ^= x >> 2
+	x ^= x >> 1
+	return x & 1
+}
@@ -0,0 +1,145 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"sort"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+// Schedule defines the variation of a parameter with the normalised time τ = t / Tf ∈ [0,1]
+//  Type:
+//   "linear" -- v(τ) = V0 + (V1 - V0) τ
+//   "exp"    -- v(τ) = V0 (V1 / V0)^τ ; i.e. geometric variation. V0 and V1 must have the same sign
+//   "step"   -- v(τ) = V0 if τ < Tau[0]; v(τ) = Vals[k] if Tau[k] ≤ τ < Tau[k+1]
+//   "cos"    -- v(τ) = V1 + (V0 - V1) (1 + cos(π τ)) / 2 ; i.e. cosine annealing
+//   "fcn"    -- v(τ) = Fcn(τ)
+//  Example of JSON parameters file:
+//     "Sched" : { "DEC" : { "Type" : "linear", "V0" : 0.9, "V1" : 0.3 } }
+type Schedule struct {
+	Type string                  // "linear", "exp", "step", "cos" or "fcn"
+	V0   float64                 // value at τ = 0
+	V1   float64                 // value at τ = 1 (not used by "step" and "fcn")
+	Tau  []float64               // [nsteps] increasing τ values where the steps happen ("step")
+	Vals []float64               // [nsteps] values after each step ("step")
+	Fcn  func(τ float64) float64 `json:"-"` // user function ("fcn"). cannot be given in JSON files
+}
+
+// Value computes the value of scheduled parameter at normalised time τ
+func (o *Schedule) Value(τ float64) (v float64) {
+	τ = math.Min(math.Max(τ, 0), 1)
+	switch o.Type {
+	case "linear":
+		return o.V0 + (o.V1-o.V0)*τ
+	case "exp":
+		return o.V0 * math.Pow(o.V1/o.V0, τ)
+	case "step":
+		v = o.V0
+		for k, tk := range o.Tau {
+			if τ >= tk {
+				v = o.Vals[k]
+			}
+		}
+		return
+	case "cos":
+		return o.V1 + (o.V0-o.V1)*(1.0+math.Cos(math.Pi*τ))/2.0
+	case "fcn":
+		return o.Fcn(τ)
+	}
+	chk.Panic("schedule type %q is invalid. options are 'linear', 'exp', 'step', 'cos' or 'fcn'", o.Type)
+	return
+}
+
+// String returns a summary of schedule
+func (o *Schedule) String() string {
+	switch o.Type {
+	case "step":
+		return io.Sf("step: %g, τ=%v → %v", o.V0, o.Tau, o.Vals)
+	case "fcn":
+		return "fcn"
+	}
+	return io.Sf("%s: %g → %g", o.Type, o.V0, o.V1)
+}
+
+// check checks schedule of parameter named key
+func (o *Schedule) check(key string) error {
+	switch o.Type {
+	case "linear", "cos":
+	case "exp":
+		if o.V0*o.V1 <= 0 {
+			return chk.Err("exponential schedule of %s requires non-zero V0 and V1 with the same sign. V0=%g and V1=%g are invalid", key, o.V0, o.V1)
+		}
+	case "step":
+		if len(o.Tau) != len(o.Vals) {
+			return chk.Err("step schedule of %s requires len(Tau) == len(Vals). %d != %d", key, len(o.Tau), len(o.Vals))
+		}
+		if !sort.Float64sAreSorted(o.Tau) {
+			return chk.Err("step schedule of %s requires increasing Tau values. Tau = %v is invalid", key, o.Tau)
+		}
+	case "fcn":
+		if o.Fcn == nil {
+			return chk.Err("function schedule of %s requires Fcn", key)
+		}
+	default:
+		return chk.Err("schedule type %q of %s is invalid. options are 'linear', 'exp', 'step', 'cos' or 'fcn'", o.Type, key)
+	}
+	return nil
+}
+
+// checkRange checks whether the values of schedule of parameter named key are within [lo,hi]
+//  Note: "linear", "exp" and "cos" schedules are monotonic; thus only V0, V1 and Vals are
+//        checked. The values of "fcn" schedules cannot be checked in advance
+func (o *Schedule) checkRange(key string, lo, hi float64) error {
+	vals := []float64{o.V0}
+	switch o.Type {
+	case "linear", "exp", "cos":
+		vals = append(vals, o.V1)
+	case "step":
+		vals = append(vals, o.Vals...)
+	case "fcn":
+		return nil
+	}
+	for _, v := range vals {
+		if v < lo || v > hi {
+			return chk.Err("schedule of %s must take values in [%g,%g]. %g is invalid", key, lo, hi, v)
+		}
+	}
+	return nil
+}
+
+// SchedKeys returns the sorted names of scheduled parameters
+func (o *Parameters) SchedKeys() (keys []string) {
+	for key := range o.Sched {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return
+}
+
+// ApplySched sets the scheduled parameters to their values at time t
+func (o *Parameters) ApplySched(t int) {
+	τ := float64(t) / float64(o.Tf)
+	for key, sch := range o.Sched {
+		*o.schedTarget(key) = sch.Value(τ)
+	}
+}
+
+// schedTarget returns the parameter corresponding to key; or nil if it cannot be scheduled
+func (o *Parameters) schedTarget(key string) *float64 {
+	switch key {
+	case "DEC":
+		return &o.DEC
+	case "IntPc":
+		return &o.IntPc
+	case "IntPm":
+		return &o.IntPm
+	case "EpsH":
+		return &o.EpsH
+	}
+	return nil
+}
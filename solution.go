@@ -20,10 +20,12 @@ type Solution struct {
 	prms  *Parameters // pointer to parameters
 	Id    int         // identifier
 	Fixed bool        // cannot be changed
+	Seed  bool        // solution was seeded (given by user) instead of generated or derived from others
 	Ova   []float64   // objective values
 	Oor   []float64   // out-of-range values
 	Flt   []float64   // floats
 	Int   []int       // ints
+	Habs  []float64   // [nh] absolute values of equality constraints (MinProb). see EpsH
 
 	// metrics
 	WinOver   []*Solution // solutions dominated by this solution
@@ -33,6 +35,7 @@ type Solution struct {
 	DistCrowd float64     // crowd distance
 	DistNeigh float64     // closest neighbour distance
 	Closest   *Solution   // closest neighbour
+	LexBkt    []float64   // [nova] buckets of objective values (lexicographic mode). see LexBuckets
 }
 
 // NewSolution allocates new Solution
@@ -42,8 +45,13 @@ func NewSolution(id, nsol int, prms *Parameters) (o *Solution) {
 	o.Id = id
 	o.Ova = make([]float64, prms.Nova)
 	o.Oor = make([]float64, prms.Noor)
-	o.Flt = make([]float64, prms.Nflt)
-	o.Int = make([]int, prms.Nint)
+	if prms.VarLen {
+		o.Flt = make([]float64, prms.LenMin*prms.Nflt, prms.LenMax*prms.Nflt)
+		o.Int = make([]int, prms.LenMin*prms.Nint, prms.LenMax*prms.Nint)
+	} else {
+		o.Flt = make([]float64, prms.Nflt)
+		o.Int = make([]int, prms.Nint)
+	}
 	o.WinOver = make([]*Solution, nsol*2)
 	return o
 }
@@ -67,17 +75,35 @@ func (o *Solution) Feasible() bool {
 	return true
 }
 
+// Ngenes returns the number of genes; i.e. the number of (Nflt,Nint) blocks in Flt and Int
+//  Note: Ngenes = 1 if VarLen == false
+func (o *Solution) Ngenes() int {
+	if o.prms.Nflt > 0 {
+		return len(o.Flt) / o.prms.Nflt
+	}
+	return len(o.Int) / o.prms.Nint
+}
+
 // CopyInto copies essential data into B
+//  Note: B.Flt and B.Int are resized to match A (variable-length chromosomes)
 func (A *Solution) CopyInto(B *Solution) {
 	B.Id = A.Id
+	B.Seed = A.Seed
+	B.Flt = B.Flt[:len(A.Flt)]
+	B.Int = B.Int[:len(A.Int)]
 	copy(B.Ova, A.Ova)
 	copy(B.Oor, A.Oor)
 	copy(B.Flt, A.Flt)
 	copy(B.Int, A.Int)
+	B.Habs = append(B.Habs[:0], A.Habs...)
+	B.LexBkt = append(B.LexBkt[:0], A.LexBkt...)
 }
 
 // Distance computes (genotype) distance between A and B
 func (A *Solution) Distance(B *Solution, fmin, fmax []float64, imin, imax []int) (dist float64) {
+	if A.prms.VarLen {
+		return A.distVarLen(B, fmin, fmax, imin, imax)
+	}
 	nflt := len(A.Flt)
 	if nflt > 0 {
 		dflt := 0.0
@@ -87,9 +113,21 @@ func (A *Solution) Distance(B *Solution, fmin, fmax []float64, imin, imax []int)
 		dist += dflt / float64(nflt)
 	}
 	nint := len(A.Int)
-	if nint > 0 {
+	if nint > 0 && A.prms.PermInt > 0 {
+		if A.prms.PermDist == "kendall" {
+			dist += PermDistKendall(A.Int, B.Int)
+		} else {
+			dist += PermDistAdjacency(A.Int, B.Int)
+		}
+	} else if nint > 0 {
 		dint := 0.0
 		for i := 0; i < nint; i++ {
+			if A.prms.IsCat(i) {
+				if A.Int[i] != B.Int[i] {
+					dint += 1.0
+				}
+				continue
+			}
 			dint += math.Abs(float64(A.Int[i]-B.Int[i])) / (float64(imax[i]-imin[i]) + 1e-15)
 		}
 		dist += dint / float64(nint)
@@ -100,8 +138,119 @@ func (A *Solution) Distance(B *Solution, fmin, fmax []float64, imin, imax []int)
 	return
 }
 
+// distVarLen computes the distance between variable-length chromosomes
+//  Note: genes at the same position are compared as in Distance; each gene present in only one of
+//        A or B contributes 1. The sum is divided by the largest number of genes
+func (A *Solution) distVarLen(B *Solution, fmin, fmax []float64, imin, imax []int) (dist float64) {
+	nflt, nint := A.prms.Nflt, A.prms.Nint
+	na, nb := A.Ngenes(), B.Ngenes()
+	nmin, nmax := utl.Imin(na, nb), utl.Imax(na, nb)
+	for g := 0; g < nmin; g++ {
+		dflt, dint := 0.0, 0.0
+		for i := 0; i < nflt; i++ {
+			k := g*nflt + i
+			dflt += math.Abs(A.Flt[k]-B.Flt[k]) / (fmax[i] - fmin[i] + 1e-15)
+		}
+		for i := 0; i < nint; i++ {
+			k := g*nint + i
+			if A.prms.IsCat(i) {
+				if A.Int[k] != B.Int[k] {
+					dint += 1.0
+				}
+				continue
+			}
+			dint += math.Abs(float64(A.Int[k]-B.Int[k])) / (float64(imax[i]-imin[i]) + 1e-15)
+		}
+		dist += (dflt + dint) / float64(nflt+nint)
+	}
+	dist += float64(nmax - nmin)
+	return dist / float64(nmax)
+}
+
+// PermDistAdjacency computes the distance between two permutations based on adjacency; i.e. the
+// fraction of (cyclic) edges in a that are not present in b
+//  Example:
+//    a = 0 1 2 3 4  edges: 01 12 23 34 40
+//    b = 0 2 1 3 4  edges: 02 21 13 34 40
+//    missing in b: 01 23  =>  dist = 2/5
+func PermDistAdjacency(a, b []int) (dist float64) {
+	n := len(a)
+	if n < 2 {
+		return
+	}
+	posb := make([]int, n)
+	for i, v := range b {
+		posb[v] = i
+	}
+	nmissing := 0
+	for i := 0; i < n; i++ {
+		d := posb[a[i]] - posb[a[(i+1)%n]]
+		if d < 0 {
+			d = -d
+		}
+		if d != 1 && d != n-1 {
+			nmissing++
+		}
+	}
+	return float64(nmissing) / float64(n)
+}
+
+// PermDistKendall computes the normalised Kendall tau distance between two permutations; i.e. the
+// fraction of pairs of items whose relative order differs in a and b
+//  Example:
+//    a = 0 1 2 3
+//    b = 1 0 3 2   discordant pairs: (0,1) and (2,3)  =>  dist = 2/6
+func PermDistKendall(a, b []int) (dist float64) {
+	n := len(a)
+	if n < 2 {
+		return
+	}
+	posb := make([]int, n)
+	for i, v := range b {
+		posb[v] = i
+	}
+	seq := make([]int, n)
+	for i, v := range a {
+		seq[i] = posb[v]
+	}
+	ninv := countInversions(seq, make([]int, n))
+	return float64(ninv) / float64(n*(n-1)/2)
+}
+
+// countInversions counts inversions in seq using merge sort. seq is sorted on exit
+func countInversions(seq, tmp []int) (count int) {
+	n := len(seq)
+	if n < 2 {
+		return
+	}
+	m := n / 2
+	count = countInversions(seq[:m], tmp[:m]) + countInversions(seq[m:], tmp[m:])
+	i, j, k := 0, m, 0
+	for i < m && j < n {
+		if seq[i] <= seq[j] {
+			tmp[k] = seq[i]
+			i++
+		} else {
+			tmp[k] = seq[j]
+			count += m - i
+			j++
+		}
+		k++
+	}
+	k += copy(tmp[k:], seq[i:m])
+	copy(tmp[k:], seq[j:n])
+	copy(seq, tmp[:n])
+	return
+}
+
 // Compare compares two solutions
 func (A *Solution) Compare(B *Solution) (A_dominates, B_dominates bool) {
+	return A.compare(B, true)
+}
+
+// compare compares two solutions. bkt: use lexicographic buckets, if available; otherwise raw
+// objective values are compared
+func (A *Solution) compare(B *Solution, bkt bool) (A_dominates, B_dominates bool) {
 	var A_nviolations, B_nviolations int
 	for i := 0; i < len(A.Oor); i++ {
 		if A.Oor[i] > 0 {
@@ -123,7 +272,7 @@ func (A *Solution) Compare(B *Solution) (A_dominates, B_dominates bool) {
 			}
 			A_dominates, B_dominates = utl.DblsParetoMin(A.Oor, B.Oor)
 			if !A_dominates && !B_dominates {
-				A_dominates, B_dominates = utl.DblsParetoMin(A.Ova, B.Ova)
+				A_dominates, B_dominates = A.compareOvas(B, bkt)
 			}
 			return
 		}
@@ -134,10 +283,24 @@ func (A *Solution) Compare(B *Solution) (A_dominates, B_dominates bool) {
 		A_dominates = true
 		return
 	}
-	A_dominates, B_dominates = utl.DblsParetoMin(A.Ova, B.Ova)
+	A_dominates, B_dominates = A.compareOvas(B, bkt)
 	return
 }
 
+// compareOvas compares objective values using either Pareto dominance or lexicographic order
+//  Note: in lexicographic mode, the buckets computed by Metrics.Compute are compared (if
+//        available) because ties within tolerances are not transitive; as in SortSolutions.
+//        Buckets are cleared by the objective function set by Optimiser.Init
+func (A *Solution) compareOvas(B *Solution, bkt bool) (A_dominates, B_dominates bool) {
+	if A.prms.Lexico {
+		if bkt && len(A.LexBkt) > 0 && len(B.LexBkt) > 0 {
+			return A.prms.lexCompare(A.LexBkt, B.LexBkt, nil)
+		}
+		return A.prms.LexCompare(A.Ova, B.Ova)
+	}
+	return utl.DblsParetoMin(A.Ova, B.Ova)
+}
+
 // Fight implements the competition between A and B
 func (A *Solution) Fight(B *Solution) (A_wins bool) {
 
@@ -150,8 +313,8 @@ func (A *Solution) Fight(B *Solution) (A_wins bool) {
 		return false
 	}
 
-	// tie: single-objective problems
-	if A.prms.Nova < 2 {
+	// tie: single-objective or lexicographic problems
+	if A.prms.Nova < 2 || A.prms.Lexico {
 		if A.DistNeigh > B.DistNeigh {
 			return true
 		}
@@ -190,176 +353,121 @@ func (A *Solution) Fight(B *Solution) (A_wins bool) {
 
 // sorting /////////////////////////////////////////////////////////////////////////////////////////
 
-////////////////////////////////////////////////////////////
-// TODO: Improve this part to handle any number of Ovas ////
-////////////////////////////////////////////////////////////
-
-type solByOva0 []*Solution
-type solByOva1 []*Solution
-type solByOva2 []*Solution
-type solByOva3 []*Solution
-type solByOva4 []*Solution
-type solByOva5 []*Solution
-type solByOva6 []*Solution
-type solByOva7 []*Solution
-type solByOva8 []*Solution
-type solByOva9 []*Solution
-type solByOva10 []*Solution
-type solByOva11 []*Solution
-type solByOva12 []*Solution
-type solByOva13 []*Solution
-type solByOva14 []*Solution
-type solByOva15 []*Solution
-type solByOva16 []*Solution
-type solByOva17 []*Solution
-type solByOva18 []*Solution
-type solByOva19 []*Solution
-
-func (o solByOva0) Len() int           { return len(o) }
-func (o solByOva0) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva0) Less(i, j int) bool { return o[i].Ova[0] < o[j].Ova[0] }
-
-func (o solByOva1) Len() int           { return len(o) }
-func (o solByOva1) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva1) Less(i, j int) bool { return o[i].Ova[1] < o[j].Ova[1] }
-
-func (o solByOva2) Len() int           { return len(o) }
-func (o solByOva2) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva2) Less(i, j int) bool { return o[i].Ova[2] < o[j].Ova[2] }
-
-func (o solByOva3) Len() int           { return len(o) }
-func (o solByOva3) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva3) Less(i, j int) bool { return o[i].Ova[3] < o[j].Ova[3] }
-
-func (o solByOva4) Len() int           { return len(o) }
-func (o solByOva4) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva4) Less(i, j int) bool { return o[i].Ova[4] < o[j].Ova[4] }
-
-func (o solByOva5) Len() int           { return len(o) }
-func (o solByOva5) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva5) Less(i, j int) bool { return o[i].Ova[5] < o[j].Ova[5] }
-
-func (o solByOva6) Len() int           { return len(o) }
-func (o solByOva6) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva6) Less(i, j int) bool { return o[i].Ova[6] < o[j].Ova[6] }
-
-func (o solByOva7) Len() int           { return len(o) }
-func (o solByOva7) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva7) Less(i, j int) bool { return o[i].Ova[7] < o[j].Ova[7] }
-
-func (o solByOva8) Len() int           { return len(o) }
-func (o solByOva8) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva8) Less(i, j int) bool { return o[i].Ova[8] < o[j].Ova[8] }
-
-func (o solByOva9) Len() int           { return len(o) }
-func (o solByOva9) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva9) Less(i, j int) bool { return o[i].Ova[9] < o[j].Ova[9] }
-
-func (o solByOva10) Len() int           { return len(o) }
-func (o solByOva10) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva10) Less(i, j int) bool { return o[i].Ova[10] < o[j].Ova[10] }
-
-func (o solByOva11) Len() int           { return len(o) }
-func (o solByOva11) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva11) Less(i, j int) bool { return o[i].Ova[11] < o[j].Ova[11] }
-
-func (o solByOva12) Len() int           { return len(o) }
-func (o solByOva12) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva12) Less(i, j int) bool { return o[i].Ova[12] < o[j].Ova[12] }
-
-func (o solByOva13) Len() int           { return len(o) }
-func (o solByOva13) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva13) Less(i, j int) bool { return o[i].Ova[13] < o[j].Ova[13] }
-
-func (o solByOva14) Len() int           { return len(o) }
-func (o solByOva14) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva14) Less(i, j int) bool { return o[i].Ova[14] < o[j].Ova[14] }
-
-func (o solByOva15) Len() int           { return len(o) }
-func (o solByOva15) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva15) Less(i, j int) bool { return o[i].Ova[15] < o[j].Ova[15] }
-
-func (o solByOva16) Len() int           { return len(o) }
-func (o solByOva16) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva16) Less(i, j int) bool { return o[i].Ova[16] < o[j].Ova[16] }
-
-func (o solByOva17) Len() int           { return len(o) }
-func (o solByOva17) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva17) Less(i, j int) bool { return o[i].Ova[17] < o[j].Ova[17] }
-
-func (o solByOva18) Len() int           { return len(o) }
-func (o solByOva18) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva18) Less(i, j int) bool { return o[i].Ova[18] < o[j].Ova[18] }
-
-func (o solByOva19) Len() int           { return len(o) }
-func (o solByOva19) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
-func (o solByOva19) Less(i, j int) bool { return o[i].Ova[19] < o[j].Ova[19] }
-
-type solByFrontThenOva0 []*Solution
-
-func (o solByFrontThenOva0) Len() int      { return len(o) }
-func (o solByFrontThenOva0) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
-func (o solByFrontThenOva0) Less(i, j int) bool {
-	if o[i].FrontId == o[j].FrontId {
-		return o[i].Ova[0] < o[j].Ova[0]
-	}
-	return o[i].FrontId < o[j].FrontId
+// keys for sorting solutions
+const (
+	KeyFront = iota // Pareto front id (ascending)
+	KeyOva          // objective value (ascending). Idx selects the objective
+	KeyOor          // sum of out-of-range values; i.e. constraint violation (ascending)
+	KeyCrowd        // crowd distance (descending)
+	KeyNeigh        // distance to closest neighbour (descending)
+)
+
+// SortKey defines one key used to rank solutions
+type SortKey struct {
+	Type int     // KeyFront, KeyOva, KeyOor, KeyCrowd or KeyNeigh
+	Idx  int     // index of objective value if Type == KeyOva
+	Tol  float64 // tolerance: values whose difference is smaller than or equal to Tol are tied. see SortSolutions
+}
+
+// Value returns the value of solution corresponding to this key
+//  Note: values corresponding to descending keys are negated
+func (o SortKey) Value(sol *Solution) float64 {
+	switch o.Type {
+	case KeyFront:
+		return float64(sol.FrontId)
+	case KeyOva:
+		return sol.Ova[o.Idx]
+	case KeyOor:
+		return sol.Violation()
+	case KeyCrowd:
+		return -sol.DistCrowd
+	case KeyNeigh:
+		return -sol.DistNeigh
+	}
+	chk.Panic("sort key type %d is invalid", o.Type)
+	return 0
+}
+
+// Violation returns the sum of (positive) out-of-range values
+func (o *Solution) Violation() (sum float64) {
+	for _, oor := range o.Oor {
+		if oor > 0 {
+			sum += oor
+		}
+	}
+	return
+}
+
+// solByKeys sorts solutions by the values of a combination of keys
+type solByKeys struct {
+	sols []*Solution
+	vals [][]float64 // [nsol][nkeys] values of keys. see SortSolutions
+}
+
+func (o solByKeys) Len() int { return len(o.sols) }
+func (o solByKeys) Swap(i, j int) {
+	o.sols[i], o.sols[j] = o.sols[j], o.sols[i]
+	o.vals[i], o.vals[j] = o.vals[j], o.vals[i]
+}
+func (o solByKeys) Less(i, j int) bool {
+	for k, a := range o.vals[i] {
+		b := o.vals[j][k]
+		if a != b {
+			return a < b
+		}
+	}
+	return false
+}
+
+// SortSolutions sorts solutions by a combination of keys given in order of priority
+//  Example: sort by front, then by constraint violation, then by f1:
+//    SortSolutions(sols, SortKey{Type: KeyFront}, SortKey{Type: KeyOor}, SortKey{Type: KeyOva, Idx: 1})
+//  Note: since ties within tolerances are not transitive, the values of keys with Tol > 0 are
+//        first grouped into buckets: starting from the smallest value, each bucket holds the
+//        values within Tol of its smallest value. Solutions in the same bucket are tied; thus
+//        the result does not depend on the input order (apart from ties, which are stable)
+func SortSolutions(s []*Solution, keys ...SortKey) {
+	vals := make([][]float64, len(s))
+	for i, sol := range s {
+		vals[i] = make([]float64, len(keys))
+		for k, key := range keys {
+			vals[i][k] = key.Value(sol)
+		}
+	}
+	for k, key := range keys {
+		if key.Tol > 0 {
+			bucketize(vals, k, key.Tol)
+		}
+	}
+	sort.Stable(solByKeys{s, vals})
+}
+
+// bucketize replaces the values of key k by the indices of buckets with width tol
+func bucketize(vals [][]float64, k int, tol float64) {
+	idx := make([]int, len(vals))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return vals[idx[a]][k] < vals[idx[b]][k] })
+	bucket, first := 0, 0.0
+	for n, i := range idx {
+		v := vals[i][k]
+		if n == 0 {
+			first = v
+		} else if v-first > tol {
+			bucket++
+			first = v
+		}
+		vals[i][k] = float64(bucket)
+	}
 }
 
 // SortByOva sorts slice of solutions in ascending order of ova
 func SortByOva(s []*Solution, idxOva int) {
-	switch idxOva {
-	case 0:
-		sort.Sort(solByOva0(s))
-	case 1:
-		sort.Sort(solByOva1(s))
-	case 2:
-		sort.Sort(solByOva2(s))
-	case 3:
-		sort.Sort(solByOva3(s))
-	case 4:
-		sort.Sort(solByOva4(s))
-	case 5:
-		sort.Sort(solByOva5(s))
-	case 6:
-		sort.Sort(solByOva6(s))
-	case 7:
-		sort.Sort(solByOva7(s))
-	case 8:
-		sort.Sort(solByOva8(s))
-	case 9:
-		sort.Sort(solByOva9(s))
-	case 10:
-		sort.Sort(solByOva10(s))
-	case 11:
-		sort.Sort(solByOva11(s))
-	case 12:
-		sort.Sort(solByOva12(s))
-	case 13:
-		sort.Sort(solByOva13(s))
-	case 14:
-		sort.Sort(solByOva14(s))
-	case 15:
-		sort.Sort(solByOva15(s))
-	case 16:
-		sort.Sort(solByOva16(s))
-	case 17:
-		sort.Sort(solByOva17(s))
-	case 18:
-		sort.Sort(solByOva18(s))
-	case 19:
-		sort.Sort(solByOva19(s))
-	default:
-		chk.Panic("this code can only handle Nova ≤ 20 for now")
-	}
+	SortSolutions(s, SortKey{Type: KeyOva, Idx: idxOva})
 }
 
 // SortByFrontThenOva sorts solutions first by front and then by ova
 func SortByFrontThenOva(s []*Solution, idxOva int) {
-	switch idxOva {
-	case 0:
-		sort.Sort(solByFrontThenOva0(s))
-	default:
-		chk.Panic("this code can only handle Nova ≤ 1 for now")
-	}
+	SortSolutions(s, SortKey{Type: KeyFront}, SortKey{Type: KeyOva, Idx: idxOva})
 }
@@ -8,6 +8,8 @@ import (
 	"math"
 	"time"
 
+	"github.com/cpmech/goga/indicators"
+	"github.com/cpmech/gosl/chk"
 	"github.com/cpmech/gosl/io"
 	"github.com/cpmech/gosl/rnd"
 	"github.com/cpmech/gosl/utl"
@@ -66,6 +68,12 @@ type Stat struct {
 	Multi_err      []float64                 // max(error(f[i]))
 	Multi_fStar    [][]float64               // reference points on Pareto front [npoints][nova]
 	Multi_IGD      []float64                 // IGD metric
+	Multi_indKeys  []string                  // quality indicators computed with Multi_fStar; e.g. "IGD+", "HV". see indicators.Names
+	Multi_hvRef    []float64                 // reference point for hypervolume. nil means slightly worse than Nadir of Multi_fStar
+	Multi_ind      map[string][]float64      // values of quality indicators [key][nsamples]. INF (0 for HV) if no solution is feasible
+
+	// RunMany: convergence
+	Histories []*History // [nsamples] convergence histories of all trials (if ConvHist != "")
 }
 
 // RunMany runs many trials in order to produce statistical data
@@ -91,6 +99,11 @@ func (o *Optimiser) RunMany(dirout, fnkey string) {
 		o.Verbose = false
 	}
 
+	// quality indicators
+	if err := o.check_indicators(); err != nil {
+		chk.Panic("%v", err)
+	}
+
 	// remove previous results
 	if fnkey != "" {
 		io.RemoveAll(dirout + "/" + fnkey + "-*.res")
@@ -104,6 +117,8 @@ func (o *Optimiser) RunMany(dirout, fnkey string) {
 	o.BestOfBestOva = make([]float64, o.Nova)
 	o.BestOfBestFlt = make([]float64, o.Nflt)
 	o.BestOfBestInt = make([]int, o.Nint)
+	o.Multi_ind = make(map[string][]float64)
+	o.Histories = nil
 
 	// perform trials
 	for itrial := 0; itrial < o.Nsamples; itrial++ {
@@ -123,9 +138,14 @@ func (o *Optimiser) RunMany(dirout, fnkey string) {
 		timeIni := time.Now()
 		o.Solve()
 		o.SysTimes[itrial] = time.Now().Sub(timeIni)
+		if o.History != nil {
+			o.Histories = append(o.Histories, o.History)
+		}
 
 		// sort
-		if o.Nova > 1 { // multi-objective
+		if o.Lexico { // lexicographic
+			SortSolutions(o.Solutions, o.LexKeys()...)
+		} else if o.Nova > 1 { // multi-objective
 			SortByFrontThenOva(o.Solutions, 0)
 		} else { // single-objective
 			SortByOva(o.Solutions, 0)
@@ -152,7 +172,7 @@ func (o *Optimiser) RunMany(dirout, fnkey string) {
 				copy(o.BestOfBestOva, best.Ova)
 				copy(o.BestOfBestFlt, best.Flt)
 				copy(o.BestOfBestInt, best.Int)
-			} else {
+			} else if !o.Lexico { // lexicographic: selected after all trials
 				if best.Ova[0] < o.BestOfBestOva[0] {
 					copy(o.BestOfBestOva, best.Ova)
 					copy(o.BestOfBestFlt, best.Flt)
@@ -236,28 +256,122 @@ func (o *Optimiser) RunMany(dirout, fnkey string) {
 				WriteAllValues(dirout, io.Sf("%s-%04d_f0min=%g", fnkey, itrial, f0min), o)
 			}
 		}
+
+		// quality indicators: worst values (INF or 0 for HV) if there are no feasible solutions
+		if o.Nova > 1 && len(o.Multi_indKeys) > 0 {
+			F := FrontOvas(o.Solutions)
+			for _, key := range o.Multi_indKeys {
+				if len(F) == 0 {
+					val := INF
+					if LargerIsBetter(key) {
+						val = 0
+					}
+					o.Multi_ind[key] = append(o.Multi_ind[key], val)
+					continue
+				}
+				val, err := indicators.Calc(key, F, o.Multi_fStar, o.Multi_hvRef)
+				if err != nil {
+					chk.Panic("cannot compute quality indicator:\n%v", err)
+				}
+				o.Multi_ind[key] = append(o.Multi_ind[key], val)
+			}
+		}
+	}
+
+	// best of all trials in lexicographic order: the buckets of all best solutions are compared
+	if o.Lexico && len(o.BestOvas[0]) > 0 {
+		F := make([][]float64, len(o.BestOvas[0]))
+		for k := range F {
+			F[k] = make([]float64, o.Nova)
+			for i := 0; i < o.Nova; i++ {
+				F[k][i] = o.BestOvas[i][k]
+			}
+		}
+		k := o.LexBest(F)
+		copy(o.BestOfBestOva, F[k])
+		for i := 0; i < o.Nflt; i++ {
+			o.BestOfBestFlt[i] = o.BestFlts[i][k]
+		}
+		for i := 0; i < o.Nint; i++ {
+			o.BestOfBestInt[i] = o.BestInts[i][k]
+		}
 	}
 }
 
 // StatIgd computes the IGD metric (smaller value means the Pareto front is wide and accurate).
 //  fStar is a matrix with reference points [npoints][nova]
+//  Note: the OVAs of feasible and non-dominated solutions are compared with the reference points
 func StatIgd(o *Optimiser, fStar [][]float64) (igd float64) {
-	for _, point := range fStar {
-		dmin := INF
-		for _, sol := range o.Solutions {
-			if sol.Feasible() {
-				d := 0.0
-				for j := 0; j < o.Nova; j++ {
-					d += (point[j] - sol.Flt[j]) * (point[j] - sol.Flt[j])
-				}
-				if d < dmin {
-					dmin = d
-				}
-			}
+	F := FrontOvas(o.Solutions)
+	if len(F) == 0 {
+		return INF
+	}
+	return indicators.IGD(F, fStar)
+}
+
+// FeasibleOvas returns the OVAs of feasible solutions [nfeasible][nova]; e.g. to compute quality
+// indicators with package indicators
+func FeasibleOvas(sols []*Solution) (F [][]float64) {
+	for _, sol := range sols {
+		if sol.Feasible() {
+			F = append(F, sol.Ova)
+		}
+	}
+	return
+}
+
+// FrontOvas returns the OVAs of feasible solutions that are not dominated by other feasible
+// solutions [nfront][nova]; i.e. the approximation front used to compute quality indicators
+//  Note: only the first of repeated points is kept. see indicators.NonDominated
+func FrontOvas(sols []*Solution) (F [][]float64) {
+	return indicators.NonDominated(FeasibleOvas(sols))
+}
+
+// check_indicators checks whether the quality indicators in Multi_indKeys can be computed with
+// Multi_fStar and Multi_hvRef
+func (o *Optimiser) check_indicators() error {
+	if o.Nova < 2 || len(o.Multi_indKeys) == 0 {
+		return nil
+	}
+	for _, key := range o.Multi_indKeys {
+		err := indicators.Check(key, o.Multi_fStar, o.Multi_hvRef)
+		if err != nil {
+			return chk.Err("invalid quality indicator in Multi_indKeys:\n%v", err)
+		}
+	}
+	for k, f := range o.Multi_fStar {
+		if len(f) != o.Nova {
+			return chk.Err("reference point %d must have %d objective values. len(Multi_fStar[%d]) = %d is invalid", k, o.Nova, k, len(f))
 		}
-		igd += math.Sqrt(dmin)
 	}
-	igd /= float64(len(fStar))
+	if o.Multi_hvRef != nil && len(o.Multi_hvRef) != o.Nova {
+		return chk.Err("reference point for hypervolume must have %d objective values. len(Multi_hvRef) = %d is invalid", o.Nova, len(o.Multi_hvRef))
+	}
+	return nil
+}
+
+// StatIndicator computes statistical information of a quality indicator computed by RunMany
+//  key -- one of Multi_indKeys; e.g. "HV"
+func StatIndicator(o *Optimiser, key string, verbose bool) (vmin, vave, vmax, vdev float64, V []float64) {
+	if len(o.Multi_ind[key]) < 2 {
+		if verbose {
+			io.Pfred("there are no samples of %s for statistical analysis\n", key)
+		}
+		return
+	}
+	o.fix_formatting_data()
+	V = make([]float64, len(o.Multi_ind[key]))
+	copy(V, o.Multi_ind[key])
+	vmin, vave, vmax, vdev = rnd.StatBasic(V, true)
+	if verbose {
+		io.Pf("\nquality indicator %s\n", key)
+		io.Pf("%smin = %g\n", key, vmin)
+		io.Pf("%save = %g\n", key, vave)
+		io.Pf("%smax = %g\n", key, vmax)
+		io.Pf("%sdev = %g\n", key, vdev)
+		io.Pf(rnd.BuildTextHist(nice(vmin, o.HistNdig)-o.HistDelEmin, nice(vmax, o.HistNdig)+o.HistDelEmax,
+			o.HistNsta, V, o.HistFmt, o.HistLen))
+	}
 	return
 }
 
@@ -0,0 +1,69 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+func Test_bounds01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("bounds01. boundary handling of floats")
+
+	// parameters
+	var prms Parameters
+	prms.Default()
+	prms.Ncpu = 1
+	prms.FltMin = []float64{0, -180}
+	prms.FltMax = []float64{10, 180}
+	prms.FltBryVar = []string{"", "periodic"}
+	prms.CalcDerived()
+
+	// within range
+	for _, kind := range []string{"proj", "reflect", "random", "midpoint", "periodic"} {
+		prms.FltBry = kind
+		chk.Scalar(tst, kind, 1e-15, prms.FltBoundary(0, 3, 5, 0, 10), 3)
+	}
+
+	// projection
+	prms.FltBry = "proj"
+	chk.Scalar(tst, "proj: lo", 1e-15, prms.FltBoundary(0, -2, 5, 0, 10), 0)
+	chk.Scalar(tst, "proj: hi", 1e-15, prms.FltBoundary(0, 12, 5, 0, 10), 10)
+
+	// reflection
+	prms.FltBry = "reflect"
+	chk.Scalar(tst, "reflect: lo", 1e-15, prms.FltBoundary(0, -2, 5, 0, 10), 2)
+	chk.Scalar(tst, "reflect: hi", 1e-15, prms.FltBoundary(0, 12, 5, 0, 10), 8)
+	chk.Scalar(tst, "reflect: far", 1e-15, prms.FltBoundary(0, 23, 5, 0, 10), 3)
+
+	// midpoint-to-parent
+	prms.FltBry = "midpoint"
+	chk.Scalar(tst, "midpoint: lo", 1e-15, prms.FltBoundary(0, -2, 5, 0, 10), 2.5)
+	chk.Scalar(tst, "midpoint: hi", 1e-15, prms.FltBoundary(0, 12, 6, 0, 10), 8)
+
+	// periodic
+	prms.FltBry = "periodic"
+	chk.Scalar(tst, "periodic: lo", 1e-15, prms.FltBoundary(0, -2, 5, 0, 10), 8)
+	chk.Scalar(tst, "periodic: hi", 1e-15, prms.FltBoundary(0, 12, 5, 0, 10), 2)
+
+	// random
+	prms.FltBry = "random"
+	for k := 0; k < 10; k++ {
+		x := prms.FltBoundary(0, 12, 5, 0, 10)
+		if x < 0 || x > 10 {
+			tst.Errorf("random reinitialisation gives x = %g out of range\n", x)
+			return
+		}
+	}
+
+	// per-variable override
+	prms.FltBry = "proj"
+	chk.Scalar(tst, "angle", 1e-13, prms.FltBoundary(1, 190, 0, -180, 180), -170)
+	chk.Scalar(tst, "angle", 1e-13, prms.FltBoundary(1, -200, 0, -180, 180), 160)
+	chk.Scalar(tst, "normalised", 1e-15, prms.FltBoundary(0, 1.5, 0.5, 0, 1), 1)
+}
@@ -0,0 +1,79 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_discrete01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("discrete01. discrete floats and categorical ints")
+
+	// parameters
+	var prms Parameters
+	prms.Default()
+	prms.Nsol = 6
+	prms.Ncpu = 1
+	prms.FltMin = []float64{0, 0}
+	prms.FltMax = []float64{1, 0}
+	prms.FltSets = [][]float64{nil, {2.5, 0.5, 1.0}}
+	prms.IntMin = []int{0, 0}
+	prms.IntMax = []int{0, 10}
+	prms.IntCats = [][]string{{"W150", "W200", "W250"}, nil}
+	prms.CalcDerived()
+	chk.IntAssert(prms.Ndis, 1)
+	chk.IntAssert(prms.Ncat, 1)
+	chk.Vector(tst, "set", 1e-15, prms.FltSets[1], []float64{2.5, 0.5, 1.0}) // not modified
+	chk.Vector(tst, "fmin", 1e-15, prms.FltMin, []float64{0, 0.5})
+	chk.Vector(tst, "fmax", 1e-15, prms.FltMax, []float64{1, 2.5})
+	chk.Ints(tst, "imax", prms.IntMax, []int{2, 10})
+
+	// snap
+	x := []float64{0.3, 0.7}
+	prms.SnapFlt(x)
+	chk.Vector(tst, "x", 1e-15, x, []float64{0.3, 0.5})
+	x = []float64{0.3, 1.8}
+	prms.SnapFlt(x)
+	chk.Vector(tst, "x", 1e-15, x, []float64{0.3, 2.5})
+	x = []float64{0.3, 9.0}
+	prms.SnapFlt(x)
+	chk.Vector(tst, "x", 1e-15, x, []float64{0.3, 2.5})
+
+	// labels
+	chk.String(tst, prms.IntLabel(0, 1), "W200")
+	chk.String(tst, prms.IntLabel(1, 7), "7")
+
+	// generation
+	sols := NewSolutions(prms.Nsol, &prms)
+	GenTrialSolutions(sols, &prms)
+	for _, sol := range sols {
+		io.Pforan("flt = %v  int = %v\n", sol.Flt, sol.Int)
+		if !(sol.Flt[1] == 0.5 || sol.Flt[1] == 1.0 || sol.Flt[1] == 2.5) {
+			tst.Errorf("discrete float %g is not allowed\n", sol.Flt[1])
+		}
+		if sol.Int[0] < 0 || sol.Int[0] > 2 {
+			tst.Errorf("categorical int %d is out of range\n", sol.Int[0])
+		}
+	}
+
+	// distance: categorical ints contribute 0 or 1
+	A, B := sols[0], sols[1]
+	copy(A.Flt, []float64{0, 0.5})
+	copy(B.Flt, []float64{0, 0.5})
+	A.Int[0], A.Int[1] = 0, 5
+	B.Int[0], B.Int[1] = 2, 5
+	fmin, fmax := []float64{0, 0.5}, []float64{1, 2.5}
+	imin, imax := []int{0, 0}, []int{2, 10}
+	chk.Scalar(tst, "dist", 1e-15, A.Distance(B, fmin, fmax, imin, imax), 0.25)
+	B.Int[0] = 1
+	chk.Scalar(tst, "dist", 1e-15, A.Distance(B, fmin, fmax, imin, imax), 0.25)
+	B.Int[0] = 0
+	chk.Scalar(tst, "dist", 1e-15, A.Distance(B, fmin, fmax, imin, imax), 0)
+}
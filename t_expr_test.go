@@ -0,0 +1,158 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_expr01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("expr01. evaluation of expressions")
+
+	sym := &ExprSymbols{
+		Nflt:     3,
+		Nint:     1,
+		FltNames: []string{"a", "b"},
+		IntNames: []string{"n"},
+		Consts:   map[string]float64{"k": 2.5},
+	}
+	x, y := []float64{0.5, -2, 3}, []int{4}
+	a, b, c, n := x[0], x[1], x[2], float64(y[0])
+	for _, test := range []struct {
+		src string
+		res float64
+	}{
+		{"x0^2 + sin(x1)", a*a + math.Sin(b)},
+		{"a^2 + sin(b)", a*a + math.Sin(b)},
+		{"-x2^2", -c * c},
+		{"2^3^2", 512},
+		{"2**-1", 0.5},
+		{"(a + b) * x2 / 2 - 1", (a+b)*c/2 - 1},
+		{"k * n + y0", 2.5*n + n},
+		{"pi * e", math.Pi * math.E},
+		{"1.5e-1 + .5 + 2E2", 0.15 + 0.5 + 200},
+		{"atan2(b, a) + hypot(3, 4) + mod(7, 3)", math.Atan2(b, a) + 5 + 1},
+		{"min(a, b, x2) + max(x2) + max(a, b)", b + c + a},
+		{"abs(b) + sqrt(x2) + exp(a) + log(x2) + sign(b)", 2 + math.Sqrt(c) + math.Exp(a) + math.Log(c) - 1},
+		{"pow(x2, a) + floor(-a) + ceil(a) + round(2.5)", math.Pow(c, a) - 1 + 1 + 3},
+		{"- - a", a},
+	} {
+		e, err := CompileExpr(test.src, sym)
+		if err != nil {
+			tst.Errorf("CompileExpr failed:\n%v\n", err)
+			continue
+		}
+		chk.Scalar(tst, test.src, 1e-14, e.Eval(x, y), test.res)
+	}
+
+	// constant folding
+	e, _ := CompileExpr("2 * pi * sin(pi/2) + k", sym)
+	if !e.node.konst {
+		tst.Errorf("constant expression should have been folded\n")
+	}
+	chk.Scalar(tst, "2π+k", 1e-15, e.Eval(nil, nil), 2*math.Pi+2.5)
+}
+
+func Test_expr02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("expr02. malformed expressions")
+
+	sym := &ExprSymbols{Nflt: 2, FltNames: []string{"alpha"}}
+	for _, test := range []struct {
+		src string
+		msg string
+	}{
+		{"", "unexpected end"},
+		{"x0 +", "unexpected end"},
+		{"x0 + * 2", `unexpected "*" at position 6`},
+		{"(x0 + 1", "missing ')'"},
+		{"x0 x1", `unexpected "x1" at position 4`},
+		{"x0 $ 2", `invalid character '$' at position 4`},
+		{"1.2.3", `invalid number "1.2.3"`},
+		{"x2 + 1", `unknown variable "x2"`},
+		{"alpah * 2", `unknown variable "alpah" at position 1 (did you mean "alpha"?)`},
+		{"sen(x0)", `unknown function "sen"`},
+		{"sin(x0, x1)", `function "sin" requires 1 argument(s). 2 were given`},
+		{"atan2(x0)", `function "atan2" requires 2 argument(s)`},
+		{"max()", `function "max" requires at least one argument`},
+		{"sin(x0 x1)", "missing ',' or ')'"},
+	} {
+		_, err := CompileExpr(test.src, sym)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("CompileExpr should have failed with %q\n", test.src)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.msg) {
+			tst.Errorf("error message should contain %q\n", test.msg)
+		}
+	}
+
+	// problem with many errors and keys
+	_, err := NewExprProb([]string{"x0 +"}, []string{"foo"}, nil, sym)
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "f0:") || !strings.Contains(err.Error(), "g0:") {
+		tst.Errorf("NewExprProb should have reported errors of f0 and g0\n")
+	}
+	f, g, h, err := ExprKeys(map[string]string{"f0": "x0", "f1": "x1", "g0": "1 - x0"})
+	if err != nil {
+		tst.Errorf("ExprKeys failed:\n%v\n", err)
+		return
+	}
+	chk.Strings(tst, "f", f, []string{"x0", "x1"})
+	chk.Strings(tst, "g", g, []string{"1 - x0"})
+	chk.IntAssert(len(h), 0)
+	for _, exprs := range []map[string]string{{"f1": "x0"}, {"f0": "x0", "q0": "x1"}, {"f0": "x0", "g01": "x1"}} {
+		_, _, _, err = ExprKeys(exprs)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("ExprKeys should have failed with %v\n", exprs)
+		}
+	}
+}
+
+func Test_expr03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("expr03. problem defined by expressions")
+
+	// Deb's problem 1
+	prob, err := NewExprProb(
+		[]string{"(x^2 + y - 11)^2 + (x + y^2 - 7)^2"},
+		[]string{"4.84 - (x - 0.05)^2 - (y - 2.5)^2", "x^2 + (y - 2.5)^2 - 4.84"},
+		nil,
+		&ExprSymbols{Nflt: 2, FltNames: []string{"x", "y"}},
+	)
+	if err != nil {
+		tst.Errorf("NewExprProb failed:\n%v\n", err)
+		return
+	}
+
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 2
+	opt.Tf = 100
+	opt.Verbose = false
+	opt.FltMin = []float64{0, 0}
+	opt.FltMax = []float64{6, 6}
+	opt.Init(GenTrialSolutions, nil, prob.MinProb, 1, 2, 0)
+	opt.Solve()
+	best, _ := GetBestFeasible(&opt, 0)
+	if best == nil {
+		tst.Errorf("there should be a feasible solution\n")
+		return
+	}
+	io.Pforan("best = %v → %v\n", best.Flt, best.Ova)
+	chk.Scalar(tst, "fmin", 1e-2, best.Ova[0], 13.59085)
+}
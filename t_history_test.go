@@ -0,0 +1,274 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_hist01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("hist01. quantiles and export of histories")
+
+	// quantiles
+	x := []float64{1, 2, 3, 4, 10}
+	chk.Scalar(tst, "q(0)   ", 1e-15, quantile(x, 0), 1)
+	chk.Scalar(tst, "q(0.5) ", 1e-15, quantile(x, 0.5), 3)
+	chk.Scalar(tst, "q(0.75)", 1e-15, quantile(x, 0.75), 4)
+	chk.Scalar(tst, "q(0.9) ", 1e-15, quantile(x, 0.9), 7.6)
+	chk.Scalar(tst, "q(1)   ", 1e-15, quantile(x, 1), 10)
+
+	// fake histories
+	newHist := func(shift float64, ntimes int) *History {
+		h := &History{
+			Fbest:   make([][]float64, 2),
+			Fmean:   make([][]float64, 2),
+			Fworst:  make([][]float64, 2),
+			IndKeys: []string{"HV"},
+			Ind:     map[string][]float64{},
+		}
+		for i := 0; i < ntimes; i++ {
+			h.Times = append(h.Times, 10*i)
+			h.Nfeval = append(h.Nfeval, 100*i+int(shift))
+			for j := 0; j < 2; j++ {
+				h.Fbest[j] = append(h.Fbest[j], shift/float64(i+1))
+				h.Fmean[j] = append(h.Fmean[j], 2*shift/float64(i+1))
+				h.Fworst[j] = append(h.Fworst[j], 3*shift/float64(i+1))
+			}
+			h.Feasible = append(h.Feasible, 1)
+			h.Nfronts = append(h.Nfronts, 3-i)
+			h.Front0 = append(h.Front0, 2+i)
+			h.Diversity = append(h.Diversity, 0.5/float64(i+1))
+			h.Ind["HV"] = append(h.Ind["HV"], shift*float64(i))
+		}
+		return h
+	}
+	hists := []*History{newHist(1, 3), newHist(3, 3), newHist(2, 4)}
+	keys := hists[0].Keys()
+	chk.Strings(tst, "keys", keys, []string{"time", "nfeval", "f0best", "f0mean", "f0worst", "f1best", "f1mean", "f1worst",
+		"feasible", "nfronts", "front0", "diversity", "HV"})
+	chk.Vector(tst, "f1worst", 1e-15, hists[1].Series("f1worst"), []float64{9, 4.5, 3})
+	chk.Vector(tst, "front0", 1e-15, hists[1].Series("front0"), []float64{2, 3, 4})
+
+	// aggregation
+	T, Q := HistoryQuantiles(hists, "f0best", []float64{0, 0.5, 1})
+	chk.Vector(tst, "T", 1e-15, T, []float64{0, 10, 20})
+	chk.Vector(tst, "min", 1e-15, Q[0], []float64{1, 0.5, 1.0 / 3.0})
+	chk.Vector(tst, "med", 1e-15, Q[1], []float64{2, 1, 2.0 / 3.0})
+	chk.Vector(tst, "max", 1e-15, Q[2], []float64{3, 1.5, 1})
+	_, Q = HistoryQuantiles(hists, "HV", []float64{0.25})
+	chk.Vector(tst, "HV", 1e-15, Q[0], []float64{0, 1.5, 3})
+
+	// CSV
+	hists[0].WriteCSV("/tmp/goga", "test_hist01")
+	b, err := io.ReadFile("/tmp/goga/test_hist01.csv")
+	if err != nil {
+		tst.Errorf("cannot read CSV file:\n%v\n", err)
+		return
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	chk.IntAssert(len(lines), 4)
+	chk.String(tst, lines[0], strings.Join(keys, ","))
+	chk.String(tst, lines[2], "10,101,0.5,1,1.5,0.5,1,1.5,1,2,3,0.25,1")
+
+	// JSON
+	err = WriteHistories("/tmp/goga", "test_hist01", hists)
+	if err != nil {
+		tst.Errorf("cannot write histories:\n%v\n", err)
+		return
+	}
+	res, err := ReadHistories("/tmp/goga/test_hist01.json")
+	if err != nil {
+		tst.Errorf("cannot read histories:\n%v\n", err)
+		return
+	}
+	chk.IntAssert(len(res), 3)
+	for k, h := range res {
+		for _, key := range keys {
+			chk.Vector(tst, key, 1e-15, h.Series(key), hists[k].Series(key))
+		}
+	}
+}
+
+func Test_hist02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("hist02. convergence history in Solve and RunMany")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	err := json.Unmarshal([]byte(`{
+		"Nsol" : 20, "Ncpu" : 2, "Tf" : 100, "DtExc" : 10, "DtOut" : 25, "Nsamples" : 3,
+		"FltMin" : [-2, -2], "FltMax" : [2, 2], "ConvHist" : "exc"
+	}`), &opt.Parameters)
+	if err != nil {
+		tst.Errorf("cannot unmarshal parameters:\n%v\n", err)
+		return
+	}
+	nf, ng, nh := 1, 1, 0
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0]*x[0] + x[1]*x[1]
+		g[0] = x[0] + x[1] - 1
+	}, nf, ng, nh)
+
+	// every exchange step
+	opt.Solve()
+	h := opt.History
+	io.Pforan("times     = %v\n", h.Times)
+	io.Pforan("fbest     = %v\n", h.Fbest[0])
+	io.Pforan("feasible  = %v\n", h.Feasible)
+	io.Pforan("diversity = %v\n", h.Diversity)
+	chk.Ints(tst, "times", h.Times, []int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100})
+	chk.IntAssert(h.Nfeval[10], opt.Nfeval)
+	for i := 1; i < len(h.Times); i++ {
+		if h.Nfeval[i] <= h.Nfeval[i-1] {
+			tst.Errorf("numbers of function evaluations must increase: %v\n", h.Nfeval)
+			return
+		}
+	}
+	for i, r := range h.Feasible {
+		if r < 0 || r > 1 || h.Fbest[0][i] > h.Fmean[0][i] || h.Fmean[0][i] > h.Fworst[0][i] {
+			tst.Errorf("history is incorrect at time %d\n", h.Times[i])
+			return
+		}
+	}
+	chk.Scalar(tst, "feasible(Tf)", 1e-15, h.Feasible[10], 1)
+	chk.Scalar(tst, "fbest(Tf)", 1e-2, h.Fbest[0][10], 0.5)
+	if h.Diversity[10] > 0.5*h.Diversity[0] {
+		tst.Errorf("diversity should decrease: %v\n", h.Diversity)
+	}
+
+	// every output time with many trials
+	opt.ConvHist = "out"
+	opt.RunMany("", "")
+	chk.IntAssert(len(opt.Histories), 3)
+	for _, h := range opt.Histories {
+		chk.Ints(tst, "times", h.Times, []int{0, 30, 50, 80, 100})
+	}
+	if opt.Histories[0] == opt.Histories[1] {
+		tst.Errorf("histories of trials must be different\n")
+	}
+	T, Q := HistoryQuantiles(opt.Histories, "f0best", []float64{0.5})
+	chk.Vector(tst, "T", 1e-15, T, []float64{0, 30, 50, 80, 100})
+	chk.Scalar(tst, "median fbest(Tf)", 1e-2, Q[0][4], 0.5)
+}
+
+func Test_hist03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("hist03. reference points of quality indicators are checked before solving")
+
+	// parameters
+	newOpt := func() *Optimiser {
+		var opt Optimiser
+		opt.Default()
+		opt.Nsol = 20
+		opt.Ncpu = 1
+		opt.Tf = 10
+		opt.Nsamples = 2
+		opt.Verbose = false
+		opt.ConvHist = "exc"
+		opt.FltMin = []float64{0, 0}
+		opt.FltMax = []float64{1, 1}
+		return &opt
+	}
+	nf, ng, nh := 2, 0, 0
+	fcn := func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0], f[1] = x[0], 1-x[0]+x[1]*x[1]
+	}
+
+	// InitErr returns configuration errors
+	for _, test := range []struct {
+		keys  []string
+		fStar [][]float64
+		hvRef []float64
+	}{
+		{[]string{"IGD+"}, nil, nil},
+		{[]string{"XYZ"}, [][]float64{{0, 1}, {1, 0}}, nil},
+		{[]string{"IGD+"}, [][]float64{{0, 1}, {1}}, nil},
+		{[]string{"HV"}, nil, []float64{2, 2, 2}},
+	} {
+		opt := newOpt()
+		opt.Multi_indKeys, opt.Multi_fStar, opt.Multi_hvRef = test.keys, test.fStar, test.hvRef
+		err := opt.InitErr(GenTrialSolutions, nil, fcn, nf, ng, nh)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("InitErr should have failed with %v, %v and %v\n", test.keys, test.fStar, test.hvRef)
+			return
+		}
+	}
+
+	// valid settings
+	opt := newOpt()
+	opt.Multi_indKeys, opt.Multi_hvRef = []string{"HV"}, []float64{2, 2}
+	err := opt.InitErr(GenTrialSolutions, nil, fcn, nf, ng, nh)
+	if err != nil {
+		tst.Errorf("InitErr failed:\n%v\n", err)
+		return
+	}
+
+	// Solve and RunMany panic before evolving if keys are set after Init
+	opt = newOpt()
+	opt.Init(GenTrialSolutions, nil, fcn, nf, ng, nh)
+	opt.Multi_indKeys = []string{"IGD+"}
+	nfeval := opt.Nfeval
+	for _, run := range []func(){opt.Solve, func() { opt.RunMany("", "") }} {
+		func() {
+			defer func() {
+				r := recover()
+				io.Pforan("%v\n", r)
+				if r == nil || !strings.Contains(io.Sf("%v", r), "IGD+") {
+					tst.Errorf("run should have panicked with missing reference points\n")
+				}
+			}()
+			run()
+		}()
+	}
+	chk.IntAssert(opt.Nfeval, nfeval)
+}
+
+func Test_hist04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("hist04. quality indicators in history without feasible solutions")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 1
+	opt.Tf = 10
+	opt.Verbose = false
+	opt.ConvHist = "exc"
+	opt.FltMin = []float64{0, 0}
+	opt.FltMax = []float64{1, 1}
+	opt.Multi_fStar = [][]float64{{0, 1}, {0.5, 0.5}, {1, 0}}
+	opt.Multi_indKeys = []string{"IGD+", "HV"}
+	nf, ng, nh := 2, 1, 0
+
+	// infeasible problem
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0], f[1] = x[0], 1-x[0]+x[1]*x[1]
+		g[0] = -1
+	}, nf, ng, nh)
+	opt.Solve()
+
+	// worst values are recorded; as in RunMany
+	hist := opt.History
+	io.Pforan("IGD+ = %v, HV = %v\n", hist.Ind["IGD+"], hist.Ind["HV"])
+	chk.IntAssert(len(hist.Ind["IGD+"]), len(hist.Times))
+	for k := range hist.Times {
+		chk.Scalar(tst, "feasible", 1e-15, hist.Feasible[k], 0)
+		chk.Scalar(tst, "IGD+", 1e-15, hist.Ind["IGD+"][k], INF)
+		chk.Scalar(tst, "HV", 1e-15, hist.Ind["HV"][k], 0)
+	}
+}
@@ -7,6 +7,7 @@ package goga
 import (
 	"testing"
 
+	"github.com/cpmech/goga/indicators"
 	"github.com/cpmech/gosl/chk"
 	"github.com/cpmech/gosl/io"
 	"github.com/cpmech/gosl/plt"
@@ -133,3 +134,139 @@ func Test_igd02(tst *testing.T) {
 		plt.SaveD("/tmp/goga", "igd02.eps")
 	}
 }
+
+func Test_igd03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("igd03. IGD uses OVAs and quality indicators in RunMany")
+
+	// reference points on the front f1 = 1 - f0
+	var fStar [][]float64
+	for _, v := range utl.LinSpace(0, 1, 101) {
+		fStar = append(fStar, []float64{v, 1 - v})
+	}
+
+	// optimiser
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 30
+	opt.Ncpu = 1
+	opt.Tf = 100
+	opt.Nsamples = 3
+	opt.Verbose = false
+	opt.FltMin = []float64{0, 0}
+	opt.FltMax = []float64{1, 1}
+	nf, ng, nh := 2, 0, 0
+
+	// objective function (OVAs are different from the floats)
+	obj := func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0], f[1] = x[0], 1-x[0]+x[1]*x[1]
+	}
+	opt.Init(GenTrialSolutions, nil, obj, nf, ng, nh)
+
+	// IGD with OVAs
+	igd := StatIgd(&opt, [][]float64{opt.Solutions[0].Ova})
+	chk.Scalar(tst, "igd: one point", 1e-15, igd, 0)
+
+	// quality indicators
+	opt.Multi_fStar = fStar
+	opt.Multi_indKeys = []string{"IGD+", "HV"}
+	opt.RunMany("", "")
+	chk.IntAssert(len(opt.Multi_IGD), 3)
+	chk.IntAssert(len(opt.Multi_ind["IGD+"]), 3)
+	chk.IntAssert(len(opt.Multi_ind["HV"]), 3)
+	_, igdAve, igdMax, _, _ := StatIndicator(&opt, "IGD+", false)
+	_, hvAve, _, _, _ := StatIndicator(&opt, "HV", false)
+	io.Pforan("IGD+ = %v, HV = %v (%v)\n", igdAve, hvAve, 1.1*1.1-0.5)
+	if igdMax > 0.05 {
+		tst.Errorf("IGD+ is too large: %g\n", igdMax)
+	}
+	chk.Scalar(tst, "HV", 0.05, hvAve, 1.1*1.1-0.5)
+	for _, v := range opt.Multi_IGD {
+		if v > 0.05 {
+			tst.Errorf("IGD is too large: %g\n", v)
+		}
+	}
+}
+
+func Test_igd04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("igd04. quality indicators in RunMany without feasible solutions")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 1
+	opt.Tf = 10
+	opt.Nsamples = 2
+	opt.Verbose = false
+	opt.FltMin = []float64{0, 0}
+	opt.FltMax = []float64{1, 1}
+	nf, ng, nh := 2, 1, 0
+
+	// infeasible problem
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0], f[1] = x[0], 1-x[0]+x[1]*x[1]
+		g[0] = -1
+	}, nf, ng, nh)
+
+	// worst values are recorded
+	opt.Multi_fStar = [][]float64{{0, 1}, {0.5, 0.5}, {1, 0}}
+	opt.Multi_indKeys = []string{"IGD+", "HV"}
+	opt.RunMany("", "")
+	io.Pforan("IGD+ = %v, HV = %v\n", opt.Multi_ind["IGD+"], opt.Multi_ind["HV"])
+	chk.Vector(tst, "IGD+", 1e-15, opt.Multi_ind["IGD+"], []float64{INF, INF})
+	chk.Vector(tst, "HV", 1e-15, opt.Multi_ind["HV"], []float64{0, 0})
+}
+
+func Test_igd05(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("igd05. quality indicators use the approximation front")
+
+	// front f1 = 1 - f0, a repeated point and dominated points
+	front := [][]float64{{0, 1}, {0.25, 0.75}, {0.5, 0.5}, {0.75, 0.25}, {1, 0}}
+	points := append(append([][]float64{}, front...), []float64{0.5, 0.5}, []float64{0.6, 0.6}, []float64{0.9, 0.9})
+
+	// optimiser
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = len(points)
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.FltMin = []float64{0, 0} // used to store points
+	opt.FltMax = []float64{1, 1} // used to store points
+	nf, ng, nh := 2, 0, 0
+
+	// generator (store points into Flt)
+	gen := func(sols []*Solution, prms *Parameters) {
+		for i, sol := range sols {
+			sol.Flt[0], sol.Flt[1] = points[i][0], points[i][1]
+		}
+	}
+
+	// objective function (copy points from Flt into Ova)
+	obj := func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0], f[1] = x[0], x[1]
+	}
+	opt.Init(gen, nil, obj, nf, ng, nh)
+
+	// approximation front
+	F := FrontOvas(opt.Solutions)
+	chk.IntAssert(len(FeasibleOvas(opt.Solutions)), len(points))
+	chk.IntAssert(len(F), len(front))
+
+	// indicators are not affected by dominated or repeated points
+	for _, key := range []string{"GD", "SP", "DELTA", "EPS+"} {
+		val, err := indicators.Calc(key, F, front, nil)
+		if err != nil {
+			tst.Errorf("Calc failed:\n%v\n", err)
+			return
+		}
+		io.Pforan("%-5s = %v\n", key, val)
+		chk.Scalar(tst, key, 1e-15, val, 0)
+	}
+	chk.Scalar(tst, "igd", 1e-15, StatIgd(&opt, front), 0)
+}
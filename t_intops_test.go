@@ -0,0 +1,269 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+func Test_intops01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("intops01. crossover of ordered sequences")
+
+	// PMX
+	A := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	B := []int{2, 6, 4, 0, 5, 7, 1, 3}
+	a, b := make([]int, 8), make([]int, 8)
+	cxIntPmx(a, b, A, B, 3, 7)
+	io.Pforan("pmx: a = %v\n", a)
+	io.Pforan("pmx: b = %v\n", b)
+	chk.Ints(tst, "pmx: a", a, []int{3, 6, 2, 0, 5, 7, 1, 4})
+	chk.Ints(tst, "pmx: b", b, []int{2, 1, 7, 3, 4, 5, 6, 0})
+
+	// CX
+	var prms Parameters
+	prms.Default()
+	prms.IntPc = 1
+	B = []int{1, 3, 5, 7, 6, 4, 2, 0}
+	CxIntCyc(a, b, A, B, &prms)
+	io.Pforan("cx: a = %v\n", a)
+	io.Pforan("cx: b = %v\n", b)
+	chk.Ints(tst, "cx: a", a, []int{0, 1, 5, 3, 6, 4, 2, 7})
+	chk.Ints(tst, "cx: b", b, []int{1, 3, 2, 7, 4, 5, 6, 0})
+
+	// OX2
+	cxIntObx(a, b, A, B, []int{1, 2, 5})
+	io.Pforan("ox2: a = %v\n", a)
+	io.Pforan("ox2: b = %v\n", b)
+	chk.Ints(tst, "ox2: a", a, []int{0, 1, 2, 3, 5, 4, 6, 7})
+	chk.Ints(tst, "ox2: b", b, []int{1, 3, 2, 7, 6, 4, 5, 0})
+
+	// ER
+	A = []int{0, 1, 2, 3, 4, 5}
+	B = []int{1, 3, 0, 5, 4, 2}
+	a, b = make([]int, 6), make([]int, 6)
+	CxIntErx(a, b, A, B, &prms)
+	io.Pforan("er: a = %v\n", a)
+	io.Pforan("er: b = %v\n", b)
+	chk.Ints(tst, "er: a", a, []int{0, 5, 4, 3, 2, 1})
+	chk.Ints(tst, "er: b", b, []int{1, 2, 3, 4, 5, 0})
+}
+
+func Test_intops02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("intops02. mutation of ordered sequences")
+
+	A := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	mtIntInv(A, 2, 6)
+	chk.Ints(tst, "inversion", A, []int{0, 1, 5, 4, 3, 2, 6, 7})
+
+	A = []int{0, 1, 2, 3, 4, 5, 6, 7}
+	mtIntIns(A, 1, 4)
+	chk.Ints(tst, "insertion (forward)", A, []int{0, 2, 3, 4, 1, 5, 6, 7})
+	mtIntIns(A, 4, 1)
+	chk.Ints(tst, "insertion (backward)", A, []int{0, 1, 2, 3, 4, 5, 6, 7})
+
+	var prms Parameters
+	prms.Default()
+	prms.IntPm = 1
+	for _, mut := range []MtInt_t{MtIntSwap, MtIntInv, MtIntIns, MtIntScr, MtIntOrd} {
+		for k := 0; k < 20; k++ {
+			mut(A, &prms)
+			if !IsPerm(A) {
+				tst.Errorf("mutation produced invalid permutation: %v\n", A)
+				return
+			}
+		}
+	}
+}
+
+func Test_intops03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("intops03. 2-opt and Or-opt")
+
+	// points on a circle: optimal tour is 0 1 2 ... n-1
+	X := []float64{2, 1, 0, -1, -2, -1, 0, 1}
+	Y := []float64{0, 1, 2, 1, 0, -1, -2, -1}
+	n := len(X)
+	D := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		D[i] = make([]float64, n)
+		for j := 0; j < n; j++ {
+			D[i][j] = math.Hypot(X[i]-X[j], Y[i]-Y[j])
+		}
+	}
+	lopt := TourLength(utl.IntRange(n), D)
+
+	// 2-opt: crossing edges are removed
+	a := []int{0, 3, 2, 1, 4, 5, 6, 7}
+	chk.Bool(tst, "improved", TwoOpt(a, D), true)
+	chk.Ints(tst, "2-opt", a, []int{0, 1, 2, 3, 4, 5, 6, 7})
+
+	// Or-opt: misplaced segment is moved
+	a = []int{0, 3, 4, 1, 2, 5, 6, 7}
+	chk.Bool(tst, "improved", OrOpt(a, D), true)
+	chk.Scalar(tst, "Or-opt: length", 1e-15, TourLength(a, D), lopt)
+	if !IsPerm(a) {
+		tst.Errorf("Or-opt produced invalid permutation: %v\n", a)
+	}
+}
+
+func Test_intops04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("intops04. bounded mutation of ints")
+
+	// parameters
+	var prms Parameters
+	prms.Default()
+	prms.IntMin = []int{-2, 0, 10}
+	prms.IntMax = []int{2, 0, 20}
+	prms.IntPm = 1
+	prms.IntNchanges = 3
+	prms.IntCreep = 5
+	prms.CalcDerived()
+
+	// check bounds
+	inside := func(A []int) bool {
+		for i, v := range A {
+			if v < prms.IntMin[i] || v > prms.IntMax[i] {
+				return false
+			}
+		}
+		return true
+	}
+	A := []int{0, 0, 15}
+	changed := false
+	for k := 0; k < 50; k++ {
+		MtIntRnd(A, &prms)
+		if A[0] != 0 {
+			changed = true
+		}
+		if !inside(A) {
+			tst.Errorf("random resetting produced values outside range: %v\n", A)
+			return
+		}
+		MtIntCreep(A, &prms)
+		if !inside(A) {
+			tst.Errorf("creep mutation produced values outside range: %v\n", A)
+			return
+		}
+	}
+	chk.Bool(tst, "zero gene changed", changed, true)
+
+	// discrete differential evolution
+	a := make([]int, 3)
+	for k := 0; k < 50; k++ {
+		DiffEvolInt(a, []int{0, 0, 15}, []int{2, 0, 20}, []int{2, 0, 20}, []int{-2, 0, 10}, &prms)
+		if !inside(a) {
+			tst.Errorf("discrete DE produced values outside range: %v\n", a)
+			return
+		}
+	}
+	chk.Scalar(tst, "enforce", 1e-15, float64(prms.EnforceIntRange(2, 25)), 20)
+}
+
+func Test_intops05(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("intops05. binary operators and Gray coding")
+
+	// Gray coding
+	gray := make([]int, 3)
+	GrayEncode(gray, 4)
+	chk.Ints(tst, "gray(4)", gray, []int{1, 1, 0})
+	for k := uint64(0); k < 8; k++ {
+		GrayEncode(gray, k)
+		if GrayDecode(gray) != k {
+			tst.Errorf("Gray coding of %d failed\n", k)
+			return
+		}
+	}
+
+	// floats
+	var prms Parameters
+	prms.Default()
+	prms.FltMin = []float64{-1, 0}
+	prms.FltMax = []float64{1, 10}
+	prms.FltBits = 10
+	prms.CalcDerived()
+	chk.IntAssert(prms.Nint, 20)
+	chk.IntAssert(prms.BinInt, 20)
+	chk.Scalar(tst, "IntPbit", 1e-15, prms.IntPbit, 1.0/20.0)
+	bits := make([]int, prms.Nint)
+	x := make([]float64, 2)
+	prms.EncodeFlt(bits, []float64{-1, 10})
+	prms.DecodeFlt(x, bits)
+	chk.Vector(tst, "x (bounds)", 1e-15, x, []float64{-1, 10})
+	prms.EncodeFlt(bits, []float64{0.3, 2.5})
+	prms.DecodeFlt(x, bits)
+	chk.Vector(tst, "x (resolution)", 0.5*10.0/1023.0, x, []float64{0.3, 2.5})
+
+	// half-uniform crossover
+	prms.IntPc = 1
+	A := []int{1, 1, 0, 0, 1, 0, 1, 0}
+	B := []int{1, 0, 1, 0, 0, 0, 1, 1}
+	a, b := make([]int, 8), make([]int, 8)
+	CxIntHux(a, b, A, B, &prms)
+	nA, nB := 0, 0
+	for i := 0; i < 8; i++ {
+		if a[i] != A[i] {
+			nA++
+		}
+		if b[i] != B[i] {
+			nB++
+		}
+		if a[i]+b[i] != A[i]+B[i] {
+			tst.Errorf("HUX must exchange genes\n")
+		}
+	}
+	chk.IntAssert(nA, 2)
+	chk.IntAssert(nB, 2)
+}
+
+func Test_intops06(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("intops06. classic binary GA with Gray-coded floats")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 30
+	opt.Ncpu = 1
+	opt.Tf = 100
+	opt.Verbose = false
+	opt.FltMin = []float64{-2, -2}
+	opt.FltMax = []float64{2, 2}
+	opt.FltBits = 12
+	opt.CxInt = CxIntUnif
+	opt.MtInt = MtIntBinBit
+	nf, ng, nh := 1, 0, 0
+
+	// initialise optimiser
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = (x[0]-1)*(x[0]-1) + (x[1]+0.5)*(x[1]+0.5)
+	}, nf, ng, nh)
+
+	// solve
+	opt.Solve()
+
+	// check consistency of decoded floats
+	x := make([]float64, 2)
+	for _, sol := range opt.Solutions {
+		opt.DecodeFlt(x, sol.Int)
+		chk.Vector(tst, "x", 1e-15, sol.Flt, x)
+	}
+	best, _ := GetBestFeasible(&opt, 0)
+	io.Pforan("best: x = %v  f = %g\n", best.Flt, best.Ova[0])
+}
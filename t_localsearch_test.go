@@ -0,0 +1,163 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_ls01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ls01. local search: Rosenbrock function")
+
+	for _, method := range []string{"nm", "hj", "cs"} {
+
+		// parameters
+		var opt Optimiser
+		opt.Default()
+		opt.Nsol = 20
+		opt.Ncpu = 1
+		opt.Tf = 20
+		opt.DtExc = 5
+		opt.Verbose = false
+		opt.LsType = method
+		opt.LsNfeval = 2000
+		opt.LsTol = 1e-9
+		opt.FltMin = []float64{-2, -2}
+		opt.FltMax = []float64{2, 2}
+		nf, ng, nh := 1, 0, 0
+
+		// initialise optimiser
+		opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+			a, b := 1.0-x[0], x[1]-x[0]*x[0]
+			f[0] = a*a + 100.0*b*b
+		}, nf, ng, nh)
+
+		// solve
+		opt.Solve()
+
+		// check
+		SortByOva(opt.Solutions, 0)
+		best := opt.Solutions[0]
+		io.Pforan("%s: nfeval = %d  x = %v  f = %g\n", method, opt.Nfeval, best.Flt, best.Ova[0])
+		if opt.Nfeval <= opt.Nsol+opt.Tf*opt.Nsol {
+			tst.Errorf("%s: evaluations of local search were not counted\n", method)
+			return
+		}
+		chk.Vector(tst, method+": x", 1e-2, best.Flt, []float64{1, 1})
+	}
+}
+
+func Test_ls02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ls02. local search with constraints")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 1
+	opt.Tf = 10
+	opt.DtExc = 2
+	opt.Verbose = false
+	opt.LsType = "nm"
+	opt.LsNsol = 3
+	opt.LsNfeval = 500
+	opt.FltMin = []float64{-2, -2}
+	opt.FltMax = []float64{2, 2}
+	nf, ng, nh := 1, 5, 0
+
+	// initialise optimiser
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0]*x[0]/2.0 + x[1]*x[1] - x[0]*x[1] - 2.0*x[0] - 6.0*x[1]
+		g[0] = 2.0 - x[0] - x[1]     // ≥ 0
+		g[1] = 2.0 + x[0] - 2.0*x[1] // ≥ 0
+		g[2] = 3.0 - 2.0*x[0] - x[1] // ≥ 0
+		g[3] = x[0]                  // ≥ 0
+		g[4] = x[1]                  // ≥ 0
+	}, nf, ng, nh)
+
+	// solve
+	opt.Solve()
+
+	// check
+	best, _ := GetBestFeasible(&opt, 0)
+	io.Pforan("x = %v  f = %g\n", best.Flt, best.Ova[0])
+	chk.Vector(tst, "x", 1e-2, best.Flt, []float64{2.0 / 3.0, 4.0 / 3.0})
+}
+
+func Test_ls03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ls03. local search with periodic floats")
+
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 10
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.LsType = "cs"
+	opt.FltMin = []float64{0, 0}
+	opt.FltMax = []float64{1, 1}
+	opt.FltBryVar = []string{"periodic", "proj"}
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0] + x[1]
+	}, 1, 0, 0)
+
+	// trial floats outside range: periodic angle wraps and the other one is projected
+	ref := opt.Solutions[0]
+	T := NewSolution(0, 0, &opt.Parameters)
+	opt.lsEval(T, ref, []float64{1.25, 1.25})
+	chk.Vector(tst, "x", 1e-15, T.Flt, []float64{0.25, 1})
+	opt.lsEval(T, ref, []float64{-0.25, -0.25})
+	chk.Vector(tst, "x", 1e-15, T.Flt, []float64{0.75, 0})
+}
+
+func Test_ls04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("ls04. local search with lexicographic comparisons")
+
+	for _, method := range []string{"nm", "hj", "cs"} {
+
+		// parameters
+		var opt Optimiser
+		opt.Default()
+		opt.Nsol = 10
+		opt.Ncpu = 1
+		opt.Verbose = false
+		opt.Lexico = true
+		opt.LsType = method
+		opt.LsNfeval = 1000
+		opt.LsTol = 1e-9
+		opt.FltMin = []float64{-1, -1}
+		opt.FltMax = []float64{1, 1}
+		opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+			f[0] = (x[0]-0.3)*(x[0]-0.3) + (x[1]-0.3)*(x[1]-0.3)
+			f[1] = x[0]
+		}, 2, 0, 0)
+
+		// buckets are set by metrics; as during Solve
+		opt.Metrics.Compute(opt.Solutions)
+		SortSolutions(opt.Solutions, opt.LexKeys()...)
+		f0 := opt.Solutions[0].Ova[0]
+
+		// refine best solution
+		nfeval := opt.LocalSearch()
+		SortSolutions(opt.Solutions, opt.LexKeys()...)
+		best := opt.Solutions[0]
+		io.Pforan("%s: nfeval = %d  x = %v  f = %g => %g\n", method, nfeval, best.Flt, f0, best.Ova[0])
+		if best.Ova[0] > 1e-8 {
+			tst.Errorf("%s: local search did not improve the objective value\n", method)
+			return
+		}
+		chk.Vector(tst, method+": x", 1e-4, best.Flt, []float64{0.3, 0.3})
+	}
+}
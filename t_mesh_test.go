@@ -0,0 +1,133 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_mesh01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("mesh01. mesh-guided movement of points")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 2
+	opt.Tf = 50
+	opt.Verbose = false
+	opt.UseMesh = true
+	opt.GenAll = true
+	opt.Nbry = 3
+	opt.DtMsh = 5
+	opt.FltMin = []float64{-1, -1, -1}
+	opt.FltMax = []float64{1, 1, 1}
+	nf, ng, nh := 1, 0, 0
+
+	// initialise optimiser
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = (x[0]-0.3)*(x[0]-0.3) + (x[1]+0.2)*(x[1]+0.2) + x[2]*x[2]
+	}, nf, ng, nh)
+
+	// check extra (boundary) solutions
+	chk.IntAssert(opt.NumExtraSols, 3*8)
+	chk.IntAssert(opt.Nsol, 20+3*8)
+	nfixed := 0
+	fixed := make(map[*Solution][]float64)
+	for k, sol := range opt.Solutions {
+		if sol.Fixed {
+			if k < opt.Nsol-opt.NumExtraSols {
+				tst.Errorf("solution %d should not be fixed\n", k)
+				return
+			}
+			fixed[sol] = append([]float64{}, sol.Flt...)
+			nfixed++
+		}
+	}
+	chk.IntAssert(nfixed, opt.NumExtraSols)
+
+	// check meshes
+	for i := 0; i < opt.Nflt-1; i++ {
+		for j := i + 1; j < opt.Nflt; j++ {
+			msh := opt.Meshes[i][j]
+			io.Pforan("mesh(%d,%d): nverts = %d, ncells = %d\n", i, j, len(msh.V), len(msh.C))
+			chk.IntAssert(len(msh.V), opt.Nsol)
+			chk.IntAssert(len(msh.Vc), opt.Nsol)
+			if len(msh.C) < opt.Nsol {
+				tst.Errorf("mesh(%d,%d) has too few cells\n", i, j)
+				return
+			}
+		}
+	}
+
+	// solve
+	opt.Solve()
+
+	// fixed solutions must not move
+	for sol, x := range fixed {
+		chk.Vector(tst, "fixed", 1e-15, sol.Flt, x)
+	}
+
+	// best solution
+	SortByOva(opt.Solutions, 0)
+	best := opt.Solutions[0]
+	io.Pforan("best = %v  f = %v\n", best.Flt, best.Ova[0])
+	chk.Vector(tst, "best", 1e-2, best.Flt, []float64{0.3, -0.2, 0})
+}
+
+func Test_mesh02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("mesh02. fixed solutions in RunMany")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 2
+	opt.Tf = 10
+	opt.Nsamples = 4
+	opt.Verbose = false
+	opt.UseMesh = true
+	opt.GenAll = true
+	opt.Nbry = 3
+	opt.DtMsh = 5
+	opt.FltMin = []float64{-1, -1, -1}
+	opt.FltMax = []float64{1, 1, 1}
+	nf, ng, nh := 1, 0, 0
+
+	// generator counting fixed solutions of each trial
+	var counts []int
+	generator := func(sols []*Solution, prms *Parameters) {
+		GenTrialSolutions(sols, prms)
+		nfixed := 0
+		for _, sol := range sols {
+			if sol.Fixed {
+				nfixed++
+			}
+		}
+		counts = append(counts, nfixed)
+	}
+
+	// initialise optimiser
+	opt.Init(generator, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = (x[0]-0.3)*(x[0]-0.3) + (x[1]+0.2)*(x[1]+0.2) + x[2]*x[2]
+	}, nf, ng, nh)
+
+	// run many trials; solutions are sorted after each trial
+	opt.RunMany("", "")
+	io.Pforan("number of fixed solutions = %v\n", counts)
+	chk.IntAssert(len(counts), opt.Nsamples)
+	for itrial, nfixed := range counts {
+		if nfixed != opt.NumExtraSols {
+			tst.Errorf("trial %d: number of fixed solutions %d should be equal to %d\n", itrial, nfixed, opt.NumExtraSols)
+		}
+	}
+}
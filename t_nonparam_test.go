@@ -0,0 +1,185 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_nonparam01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("nonparam01. rank-sum and signed-rank tests")
+
+	// ranks with ties
+	r, ties := ranks([]float64{3, 1, 4, 1, 5})
+	chk.Vector(tst, "ranks", 1e-15, r, []float64{3, 1.5, 4, 1.5, 5})
+	chk.Scalar(tst, "ties", 1e-15, ties, 6)
+
+	// R: wilcox.test(x, y, alternative="greater") => W = 35, p-value = 0.1272
+	x := []float64{0.80, 0.83, 1.89, 1.04, 1.45, 1.38, 1.91, 1.64, 0.73, 1.46}
+	y := []float64{1.15, 0.88, 0.90, 0.74, 1.21}
+	U, p := RankSum(x, y)
+	io.Pforan("U = %v, p = %v\n", U, p)
+	chk.Scalar(tst, "U", 1e-15, U, 35)
+	chk.Scalar(tst, "p", 1e-4, p, 2*0.1272)
+	chk.Scalar(tst, "A12", 1e-15, A12(x, y), 0.7)
+	_, pyx := RankSum(y, x)
+	chk.Scalar(tst, "p(y,x)", 1e-15, pyx, p)
+
+	// R: wilcox.test(x, y, paired=TRUE, alternative="greater") => V = 40, p-value = 0.01953
+	x = []float64{1.83, 0.50, 1.62, 2.48, 1.68, 1.88, 1.55, 3.06, 1.30}
+	y = []float64{0.878, 0.647, 0.598, 2.05, 1.06, 1.29, 1.06, 3.14, 1.29}
+	W, p := SignedRank(x, y)
+	io.Pforan("W = %v, p = %v\n", W, p)
+	chk.Scalar(tst, "W", 1e-15, W, 40)
+	chk.Scalar(tst, "p", 1e-4, p, 2*0.01953)
+
+	// normal approximation with ties: the p-value must be close to the exact one without ties
+	x2 := append(append([]float64{}, x...), x...)
+	y2 := append(append([]float64{}, y...), y...)
+	_, pex := RankSum(x[:8], y[:8])
+	_, pnr := RankSum(append(append([]float64{}, x[:8]...), x[0]), append(append([]float64{}, y[:8]...), x[0]))
+	io.Pforan("pex = %v, pnr = %v\n", pex, pnr)
+	chk.Scalar(tst, "p: exact vs normal", 0.1, pnr, pex)
+	_, p = SignedRank(x2, y2)
+	if p > 0.01 {
+		tst.Errorf("p-value of repeated samples should be smaller: %g\n", p)
+	}
+
+	// equal samples
+	_, p = SignedRank(x, x)
+	chk.Scalar(tst, "p: equal samples", 1e-15, p, 1)
+	_, p = RankSum([]float64{1, 1, 1}, []float64{1, 1})
+	chk.Scalar(tst, "p: all ties", 1e-15, p, 1)
+	chk.Scalar(tst, "A12: all ties", 1e-15, A12([]float64{1, 1, 1}, []float64{1, 1}), 0.5)
+	chk.String(tst, A12Magnitude(0.7), "medium")
+	chk.String(tst, A12Magnitude(0.2), "large")
+	chk.String(tst, A12Magnitude(0.45), "negligible")
+}
+
+func Test_nonparam02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("nonparam02. Kruskal-Wallis, Friedman and post-hoc tests")
+
+	// distributions
+	for _, test := range []struct {
+		x  float64
+		ν  int
+		sf float64
+	}{
+		{3.841458820694124, 1, 0.05}, {5.991464547107979, 2, 0.05}, {7.814727903251178, 3, 0.05},
+		{11.070497693516351, 5, 0.05}, {23.209251158954356, 10, 0.01}, {0, 3, 1},
+	} {
+		chk.Scalar(tst, io.Sf("chi2Sf(%g,%d)", test.x, test.ν), 1e-12, chi2Sf(test.x, test.ν), test.sf)
+	}
+	chk.Scalar(tst, "ptukey(3.314,3)", 1e-3, studRangeCdf(2.343*math.Sqrt2, 3), 0.95)
+	chk.Scalar(tst, "ptukey(3.858,5)", 1e-3, studRangeCdf(2.728*math.Sqrt2, 5), 0.95)
+	chk.Scalar(tst, "ptukey(2.772,2)", 1e-9, studRangeCdf(1.959963984540054*math.Sqrt2, 2), 0.95)
+
+	// R: kruskal.test(list(x, y, z)) => chi-squared = 0.77143, df = 2, p-value = 0.68
+	x := []float64{2.9, 3.0, 2.5, 2.6, 3.2}
+	y := []float64{3.8, 2.7, 4.0, 2.4}
+	z := []float64{2.8, 3.4, 3.7, 2.2, 2.0}
+	H, p := KruskalWallis([][]float64{x, y, z})
+	io.Pforan("H = %v, p = %v\n", H, p)
+	chk.Scalar(tst, "H", 1e-14, H, 0.77142857142857)
+	chk.Scalar(tst, "p", 1e-14, p, math.Exp(-H/2))
+
+	// Friedman: identical ranking in all blocks => Q = N(k-1)
+	data := [][]float64{{1, 2, 3}, {10, 20, 30}, {0.1, 0.5, 0.7}, {-3, -2, -1}}
+	Q, p, R := Friedman(data)
+	chk.Scalar(tst, "Q", 1e-14, Q, 8)
+	chk.Scalar(tst, "p", 1e-14, p, math.Exp(-4))
+	chk.Vector(tst, "R", 1e-15, R, []float64{1, 2, 3})
+
+	// post-hoc: z = 2/sqrt(3·4/24) = 2√2 between treatments 0 and 2
+	P := PostHocHolm(data)
+	z01, z02 := 1/math.Sqrt(0.5), 2/math.Sqrt(0.5)
+	p01, p02 := math.Erfc(z01/math.Sqrt2), math.Erfc(z02/math.Sqrt2)
+	chk.Scalar(tst, "P02", 1e-15, P[0][2], 3*p02)
+	chk.Scalar(tst, "P01", 1e-15, P[0][1], 2*p01)
+	chk.Scalar(tst, "P12", 1e-15, P[1][2], 2*p01)
+	chk.Scalar(tst, "P00", 1e-15, P[0][0], 1)
+	P = PostHocNemenyi(data)
+	chk.Scalar(tst, "P02", 1e-12, P[0][2], P[2][0])
+	chk.Scalar(tst, "P00", 1e-15, P[0][0], 1)
+	if P[0][2] > P[0][1] || P[0][2] < p02 { // Nemenyi is more conservative than the unadjusted test
+		tst.Errorf("Nemenyi p-values are incorrect: %v\n", P)
+	}
+
+	// Holm
+	chk.Vector(tst, "Holm", 1e-15, Holm([]float64{0.01, 0.04, 0.03, 0.005}), []float64{0.03, 0.06, 0.06, 0.02})
+}
+
+func Test_nonparam03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("nonparam03. comparison of optimisers and significance markers")
+
+	// optimisers with fake samples
+	newOpt := func(name string, f []float64) *Optimiser {
+		opt := new(Optimiser)
+		opt.Default()
+		opt.Nova = 1
+		opt.Nsamples = len(f)
+		opt.RptName = name
+		opt.BestOvas = [][]float64{f}
+		opt.Multi_ind = map[string][]float64{"HV": f}
+		return opt
+	}
+	good := []float64{1.1, 1.0, 1.2, 1.3, 1.05, 1.15, 1.25, 1.02, 1.12, 1.22}
+	bad := []float64{2.1, 2.0, 2.2, 2.3, 2.05, 2.15, 2.25, 2.02, 2.12, 2.22}
+	same := []float64{1.11, 1.01, 1.21, 1.31, 1.06, 1.16, 1.26, 1.03, 1.13, 1.23}
+	A, B, C := newOpt("A", good), newOpt("B", bad), newOpt("C", same)
+
+	res := CompareOpts(A, B, "f0", 0.05, false)
+	chk.IntAssert(res.Sign, 1)
+	chk.Scalar(tst, "A12", 1e-15, res.A12, 0)
+	chk.IntAssert(CompareOpts(B, A, "f0", 0.05, true).Sign, -1)
+	chk.IntAssert(CompareOpts(A, C, "f0", 0.05, false).Sign, 0)
+	chk.IntAssert(CompareOpts(A, B, "HV", 0.05, false).Sign, -1)
+	chk.Vector(tst, "samples", 1e-15, StatSamples(A, "f0"), good)
+
+	// many optimisers
+	p, P := CompareMany([]*Optimiser{A, B, C}, "f0")
+	io.Pforan("p = %v\nP = %v\n", p, P)
+	if p > 0.01 || P[0][1] > 0.01 || P[0][2] < 0.05 {
+		tst.Errorf("comparison of many optimisers failed. p=%g, P=%v\n", p, P)
+	}
+
+	// configurations over problems
+	configs := [][]*Optimiser{{A, A, A}, {B, B, B}, {C, C, C}}
+	R, p, P := RankConfigs(configs, "f0", "holm")
+	chk.Vector(tst, "R", 1e-15, R, []float64{1, 3, 2})
+	io.Pforan("p = %v\nP = %v\n", p, P)
+	R, _, _ = RankConfigs(configs, "HV", "nemenyi")
+	chk.Vector(tst, "R(HV)", 1e-15, R, []float64{3, 1, 2})
+
+	// markers in report
+	rpt := NewTexReport([]*Optimiser{A, C, B})
+	rpt.Versus = []*Optimiser{B, A, A}
+	rpt.RunPDF = false
+	rpt.ShowX01 = false
+	rpt.Fnkey = "test_nonparam03"
+	rpt.Generate()
+	b, err := io.ReadFile("/tmp/goga/test_nonparam03.tex")
+	if err != nil {
+		tst.Errorf("cannot read report:\n%v\n", err)
+		return
+	}
+	tex := string(b)
+	for _, mark := range []string{"$^{+}$", "$^{\\approx}$", "$^{-}$", "rank-sum test"} {
+		if !strings.Contains(tex, mark) {
+			tst.Errorf("report should contain %q\n", mark)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_prmsio01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("prmsio01. round-trip writing of parameters")
+
+	// parameters
+	var A Parameters
+	A.Default()
+	A.Nova = 2
+	A.Nsol = 30
+	A.GenType = "sobol"
+	A.UseMesh = true
+	A.GenAll = true
+	A.FltMin = []float64{-1, 0}
+	A.FltMax = []float64{1, 2}
+	A.IntMin = []int{0}
+	A.IntMax = []int{2}
+	A.IntCats = [][]string{{"a", "b", "c"}}
+	A.Sched = map[string]*Schedule{"DEC": {Type: "step", V0: 0.9, Tau: []float64{0.5}, Vals: []float64{0.5}}}
+	A.Verbose = false
+	A.CalcDerived()
+
+	// write and read
+	fn := "/tmp/goga/prmsio01.json"
+	err := A.Write(fn)
+	if err != nil {
+		tst.Errorf("Write failed:\n%v\n", err)
+		return
+	}
+	var B Parameters
+	err = B.ReadErr(fn)
+	if err != nil {
+		tst.Errorf("ReadErr failed:\n%v\n", err)
+		return
+	}
+	chk.IntAssert(B.Nsol, 30)
+	B.CalcDerived()
+	if !reflect.DeepEqual(A, B) {
+		tst.Errorf("parameters are different after round-trip:\n%v\n%v\n", A.LogParams(), B.LogParams())
+	}
+}
+
+func Test_prmsio02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("prmsio02. YAML and TOML input")
+
+	yml := `
+Nsol: 40
+ncpu: 2
+GenType: halton
+FltMin: [-1, -1]
+FltMax: [1, 1]
+Sched:
+  DEC:
+    Type: linear
+    V0: 0.9
+    V1: 0.5
+`
+	tml := `
+Nsol = 40
+ncpu = 2
+GenType = "halton"
+FltMin = [-1.0, -1.0]
+FltMax = [1.0, 1.0]
+
+[Sched.DEC]
+Type = "linear"
+V0 = 0.9
+V1 = 0.5
+`
+	io.WriteFileSD("/tmp/goga", "prmsio02.yaml", yml)
+	io.WriteFileSD("/tmp/goga", "prmsio02.toml", tml)
+	for _, fn := range []string{"/tmp/goga/prmsio02.yaml", "/tmp/goga/prmsio02.toml"} {
+		var prms Parameters
+		err := prms.ReadErr(fn)
+		if err != nil {
+			tst.Errorf("ReadErr failed:\n%v\n", err)
+			return
+		}
+		chk.IntAssert(prms.Nsol, 40)
+		chk.IntAssert(prms.Ncpu, 2)
+		chk.Vector(tst, "FltMin", 1e-15, prms.FltMin, []float64{-1, -1})
+		chk.Scalar(tst, "DEC(0.5)", 1e-15, prms.Sched["DEC"].Value(0.5), 0.7)
+		if prms.GenType != "halton" {
+			tst.Errorf("GenType is incorrect: %q\n", prms.GenType)
+		}
+		var other Parameters
+		other.Read(fn)
+		chk.IntAssert(other.Nsol, 40)
+	}
+
+	// errors
+	io.WriteFileSD("/tmp/goga", "prmsio02-bad.yaml", "Nsol: 40\nNcpuu: 2\n")
+	var prms Parameters
+	err := prms.ReadErr("/tmp/goga/prmsio02-bad.yaml")
+	io.Pforan("%v\n", err)
+	if err == nil {
+		tst.Errorf("ReadErr should have failed\n")
+	}
+}
+
+func Test_prmsio03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("prmsio03. JSON Schema")
+
+	var prms Parameters
+	b, err := prms.Schema()
+	if err != nil {
+		tst.Errorf("Schema failed:\n%v\n", err)
+		return
+	}
+	err = prms.WriteSchema("/tmp/goga/goga-params.schema.json")
+	if err != nil {
+		tst.Errorf("WriteSchema failed:\n%v\n", err)
+		return
+	}
+	var schema struct {
+		Properties map[string]struct {
+			Type        interface{}
+			Description string
+			Default     interface{}
+			Minimum     *float64
+			Maximum     *float64
+			Enum        []string
+		}
+	}
+	err = json.Unmarshal(b, &schema)
+	if err != nil {
+		tst.Errorf("cannot unmarshal schema:\n%v\n", err)
+		return
+	}
+	nsol := schema.Properties["Nsol"]
+	io.Pforan("Nsol = %+v\n", nsol)
+	if nsol.Type != "integer" || nsol.Description != "total number of solutions" || *nsol.Minimum != 6 {
+		tst.Errorf("schema of Nsol is incorrect\n")
+	}
+	dec := schema.Properties["DEC"]
+	if dec.Type != "number" || dec.Default != 0.8 || *dec.Minimum != 0 || *dec.Maximum != 1 {
+		tst.Errorf("schema of DEC is incorrect\n")
+	}
+	chk.Strings(tst, "GenType", schema.Properties["GenType"].Enum, []string{"latin", "halton", "rnd", "sobol", "maximin", "opposition"})
+	if schema.Properties["FltMin"].Description != "minimum float allowed" {
+		tst.Errorf("schema of FltMin is incorrect\n")
+	}
+	if _, ok := schema.Properties["Nflt"]; ok {
+		tst.Errorf("derived parameters must not be in schema\n")
+	}
+}
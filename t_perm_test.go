@@ -0,0 +1,168 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_perm01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("perm01. distances between permutations")
+
+	a := []int{0, 1, 2, 3, 4}
+	b := []int{0, 2, 1, 3, 4}
+	chk.Scalar(tst, "adjacency(a,a)", 1e-15, PermDistAdjacency(a, a), 0)
+	chk.Scalar(tst, "adjacency(a,b)", 1e-15, PermDistAdjacency(a, b), 2.0/5.0)
+	chk.Scalar(tst, "adjacency(a,rev)", 1e-15, PermDistAdjacency(a, []int{4, 3, 2, 1, 0}), 0)
+
+	c := []int{0, 1, 2, 3}
+	d := []int{1, 0, 3, 2}
+	chk.Scalar(tst, "kendall(c,c)", 1e-15, PermDistKendall(c, c), 0)
+	chk.Scalar(tst, "kendall(c,d)", 1e-15, PermDistKendall(c, d), 2.0/6.0)
+	chk.Scalar(tst, "kendall(c,rev)", 1e-15, PermDistKendall(c, []int{3, 2, 1, 0}), 1)
+
+	chk.Bool(tst, "IsPerm(a)", IsPerm(a), true)
+	chk.Bool(tst, "IsPerm(repeated)", IsPerm([]int{0, 1, 1}), false)
+	chk.Bool(tst, "IsPerm(out of range)", IsPerm([]int{0, 3, 1}), false)
+
+	e := []int{2, 0, 2, 7, 1}
+	chk.Bool(tst, "RepairPerm(e)", RepairPerm(e), true)
+	chk.Ints(tst, "repaired", e, []int{2, 0, 3, 4, 1})
+	chk.Bool(tst, "RepairPerm(a)", RepairPerm(a), false)
+	chk.Ints(tst, "unchanged", a, []int{0, 1, 2, 3, 4})
+}
+
+func Test_perm02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("perm02. permutation chromosome")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 2
+	opt.Tf = 50
+	opt.Verbose = false
+	opt.PermInt = 8
+	opt.IntPm = 0.5
+	nf, ng, nh := 1, 0, 0
+
+	// initialise optimiser: find identity permutation
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = 0
+		for i, v := range ξ {
+			f[0] += math.Abs(float64(v - i))
+		}
+	}, nf, ng, nh)
+
+	// check initial solutions
+	for _, sol := range opt.Solutions {
+		if !IsPerm(sol.Int) {
+			tst.Errorf("invalid initial permutation: %v\n", sol.Int)
+			return
+		}
+	}
+
+	// solve
+	opt.Solve()
+
+	// check final solutions
+	for _, sol := range opt.Solutions {
+		if !IsPerm(sol.Int) {
+			tst.Errorf("invalid final permutation: %v\n", sol.Int)
+			return
+		}
+	}
+	SortByOva(opt.Solutions, 0)
+	io.Pforan("best = %v  f = %g\n", opt.Solutions[0].Int, opt.Solutions[0].Ova[0])
+}
+
+func Test_perm03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("perm03. invalid permutations from user operators are repaired")
+
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 2
+	opt.Tf = 20
+	opt.Verbose = false
+	opt.PermInt = 6
+	opt.MtInt = func(a []int, prms *Parameters) { // broken mutation: duplicates a value
+		a[0] = a[1]
+	}
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = float64(ξ[0])
+	}, 1, 0, 0)
+	opt.Solve()
+	for _, sol := range opt.Solutions {
+		if !IsPerm(sol.Int) {
+			tst.Errorf("invalid final permutation: %v\n", sol.Int)
+			return
+		}
+	}
+}
+
+func Test_perm04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("perm04. 2-opt and Or-opt require PermInt and DistMat")
+
+	newOpt := func() *Optimiser {
+		var opt Optimiser
+		opt.Default()
+		opt.Nsol = 10
+		opt.Ncpu = 1
+		opt.Verbose = false
+		opt.PermInt = 4
+		opt.Perm2opt = true
+		opt.PermOrOp = true
+		return &opt
+	}
+	fcn := func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = float64(ξ[0])
+	}
+
+	// missing or invalid distance matrix
+	for _, D := range [][][]float64{nil, {{0, 1}, {1, 0}}} {
+		opt := newOpt()
+		opt.DistMat = D
+		err := opt.InitErr(GenTrialSolutions, nil, fcn, 1, 0, 0)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("InitErr should have failed with DistMat = %v\n", D)
+			return
+		}
+	}
+
+	// without permutations
+	prms := newOpt().Parameters
+	prms.Nova = 1
+	prms.PermInt = 0
+	prms.FltMin = []float64{0, 0}
+	prms.FltMax = []float64{1, 1}
+	err := prms.Validate()
+	io.Pforan("%v\n", err)
+	if err == nil {
+		tst.Errorf("Validate should have failed without PermInt\n")
+		return
+	}
+
+	// valid
+	opt := newOpt()
+	opt.DistMat = [][]float64{{0, 1, 2, 1}, {1, 0, 1, 2}, {2, 1, 0, 1}, {1, 2, 1, 0}}
+	err = opt.InitErr(GenTrialSolutions, nil, fcn, 1, 0, 0)
+	if err != nil {
+		tst.Errorf("InitErr failed:\n%v\n", err)
+	}
+}
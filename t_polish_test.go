@@ -0,0 +1,208 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_polish01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("polish01. quadratic function with inequalities and gradients")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 1
+	opt.Tf = 5
+	opt.Verbose = chk.Verbose
+	opt.FltMin = []float64{-2, -2}
+	opt.FltMax = []float64{2, 2}
+	nf, ng, nh := 1, 5, 0
+
+	// gradients
+	opt.MinGrad = func(dfdx, dgdx, dhdx [][]float64, x []float64, ξ []int, cpu int) {
+		dfdx[0][0], dfdx[0][1] = x[0]-x[1]-2.0, 2.0*x[1]-x[0]-6.0
+		dgdx[0][0], dgdx[0][1] = -1, -1
+		dgdx[1][0], dgdx[1][1] = 1, -2
+		dgdx[2][0], dgdx[2][1] = -2, -1
+		dgdx[3][0], dgdx[3][1] = 1, 0
+		dgdx[4][0], dgdx[4][1] = 0, 1
+	}
+
+	// initialise optimiser
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0]*x[0]/2.0 + x[1]*x[1] - x[0]*x[1] - 2.0*x[0] - 6.0*x[1]
+		g[0] = 2.0 - x[0] - x[1]     // ≥ 0
+		g[1] = 2.0 + x[0] - 2.0*x[1] // ≥ 0
+		g[2] = 3.0 - 2.0*x[0] - x[1] // ≥ 0
+		g[3] = x[0]                  // ≥ 0
+		g[4] = x[1]                  // ≥ 0
+	}, nf, ng, nh)
+
+	// solve
+	opt.Solve()
+
+	// check
+	res := opt.Polished
+	io.Pforan("%v\n", res)
+	chk.Bool(tst, "done", res.Done, true)
+	if res.Ova1 > res.Ova0 {
+		tst.Errorf("polishing increased the objective value\n")
+		return
+	}
+	best, _ := GetBestFeasible(&opt, 0)
+	io.Pforan("x = %v  f = %v\n", best.Flt, best.Ova[0])
+	chk.Vector(tst, "x", 1e-6, best.Flt, []float64{2.0 / 3.0, 4.0 / 3.0})
+	chk.Scalar(tst, "f", 1e-6, best.Ova[0], -74.0/9.0)
+}
+
+func Test_polish02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("polish02. equality constraint")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 1
+	opt.Tf = 5
+	opt.Verbose = chk.Verbose
+	opt.FltMin = []float64{-2, -2}
+	opt.FltMax = []float64{2, 2}
+	nf, ng, nh := 1, 0, 1
+
+	// gradients
+	opt.MinGrad = func(dfdx, dgdx, dhdx [][]float64, x []float64, ξ []int, cpu int) {
+		dfdx[0][0], dfdx[0][1] = 2.0*x[0], 2.0*x[1]
+		dhdx[0][0], dhdx[0][1] = 1, 1
+	}
+
+	// initialise optimiser
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0]*x[0] + x[1]*x[1]
+		h[0] = x[0] + x[1] - 1.0
+	}, nf, ng, nh)
+
+	// solve
+	opt.Solve()
+
+	// check
+	io.Pforan("%v\n", opt.Polished)
+	best, _ := GetBestFeasible(&opt, 0)
+	io.Pforan("x = %v  f = %v\n", best.Flt, best.Ova[0])
+	chk.Vector(tst, "x", 1e-6, best.Flt, []float64{0.5, 0.5})
+	chk.Scalar(tst, "f", 1e-6, best.Ova[0], 0.5)
+}
+
+func Test_polish03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("polish03. periodic, discrete and binary-encoded floats")
+
+	// periodic float: minimum at 0.05 is reached by wrapping from 0.9 across 1
+	var opt Optimiser
+	opt.Default()
+	opt.Nova = 1
+	opt.FltMin = []float64{0}
+	opt.FltMax = []float64{1}
+	opt.FltBry = "periodic"
+	opt.CalcDerived()
+	x := []float64{0.9}
+	opt.polishBfgs(x, func(dL, x []float64) float64 {
+		if dL != nil {
+			dL[0] = 2.0 * math.Pi * math.Sin(2.0*math.Pi*(x[0]-0.05))
+		}
+		return 1.0 - math.Cos(2.0*math.Pi*(x[0]-0.05))
+	})
+	io.Pforan("x = %v\n", x)
+	chk.Scalar(tst, "x", 1e-6, x[0], 0.05)
+
+	// discrete float: polished solution is snapped to the set
+	set := []float64{0, 1, 2}
+	opt = Optimiser{}
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 1
+	opt.Tf = 5
+	opt.Verbose = false
+	opt.FltMin = []float64{-2, 0}
+	opt.FltMax = []float64{2, 0}
+	opt.FltSets = [][]float64{nil, set}
+	opt.MinGrad = func(dfdx, dgdx, dhdx [][]float64, x []float64, ξ []int, cpu int) {
+		dfdx[0][0], dfdx[0][1] = 2.0*(x[0]-0.3), 2.0*(x[1]-1.2)
+	}
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = math.Pow(x[0]-0.3, 2) + math.Pow(x[1]-1.2, 2)
+	}, 1, 0, 0)
+	opt.Solve()
+	chk.Bool(tst, "polished", opt.Polished.Done, true)
+	for _, sol := range opt.Solutions {
+		if sol.Flt[1] != 0 && sol.Flt[1] != 1 && sol.Flt[1] != 2 {
+			tst.Errorf("discrete float is not in set: %v\n", sol.Flt)
+			return
+		}
+	}
+
+	// binary-encoded floats cannot be polished
+	opt = Optimiser{}
+	opt.Default()
+	opt.Nsol = 10
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.FltMin = []float64{-1}
+	opt.FltMax = []float64{1}
+	opt.FltBits = 8
+	opt.MinGrad = func(dfdx, dgdx, dhdx [][]float64, x []float64, ξ []int, cpu int) {}
+	err := opt.InitErr(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0] * x[0]
+	}, 1, 0, 0)
+	io.Pforan("%v\n", err)
+	if err == nil {
+		tst.Errorf("InitErr should have failed with FltBits and MinGrad\n")
+	}
+}
+
+func Test_polish04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("polish04. lexicographic comparisons")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 10
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.Lexico = true
+	opt.FltMin = []float64{-2, -2}
+	opt.FltMax = []float64{2, 2}
+	opt.MinGrad = func(dfdx, dgdx, dhdx [][]float64, x []float64, ξ []int, cpu int) {
+		dfdx[0][0], dfdx[0][1] = 2.0*(x[0]-0.3), 2.0*(x[1]+0.4)
+	}
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = math.Pow(x[0]-0.3, 2) + math.Pow(x[1]+0.4, 2)
+	}, 1, 0, 0)
+
+	// buckets are set by metrics; as during Solve
+	opt.Metrics.Compute(opt.Solutions)
+
+	// polish
+	res := opt.Polish()
+	io.Pforan("%v\n", res)
+	if res.Ova1 >= res.Ova0 {
+		tst.Errorf("polished solution was discarded\n")
+		return
+	}
+	best, _ := GetBestFeasible(&opt, 0)
+	chk.Vector(tst, "x", 1e-6, best.Flt, []float64{0.3, -0.4})
+}
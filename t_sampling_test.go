@@ -0,0 +1,197 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/rnd"
+)
+
+func Test_sampling01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sampling01. Sobol sequences")
+
+	// primitive polynomials: 1, 1, 2, 2, 6 and 6 of degrees 1 to 6
+	polys := sobolPolys(18)
+	ndeg := make([]int, 7)
+	for _, p := range polys {
+		ndeg[p[0]]++
+	}
+	chk.Ints(tst, "number of polynomials", ndeg, []int{0, 1, 1, 2, 2, 6, 6})
+	chk.Bool(tst, "x²+x+1", isPrimitive(7, 2), true)
+	chk.Bool(tst, "x⁴+x³+x²+x+1", isPrimitive(31, 4), false)
+
+	// unscrambled
+	X := SobolPoints(2, 4, false)
+	io.Pforan("X = %v\n", X)
+	chk.Vector(tst, "x0", 1e-15, X[0], []float64{0, 0.5, 0.75, 0.25})
+	chk.Vector(tst, "x1", 1e-15, X[1], []float64{0, 0.5, 0.25, 0.75})
+
+	// scrambled: one point per elementary interval of size 1/n along each direction
+	rnd.Init(1234)
+	n := 16
+	ones := make([]int, n)
+	for i := 0; i < n; i++ {
+		ones[i] = 1
+	}
+	X = SobolPoints(25, n, true)
+	for j := 0; j < len(X); j++ {
+		count := make([]int, n)
+		for i := 0; i < n; i++ {
+			if X[j][i] < 0 || X[j][i] >= 1 {
+				tst.Errorf("coordinate %g is out of [0,1)\n", X[j][i])
+				return
+			}
+			count[int(X[j][i]*float64(n))]++
+		}
+		chk.Ints(tst, io.Sf("count%d", j), count, ones)
+	}
+}
+
+func Test_sampling02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sampling02. maximin Latin hypercube")
+
+	rnd.Init(1234)
+	dim, n := 2, 10
+	ones := make([]int, n)
+	for i := 0; i < n; i++ {
+		ones[i] = 1
+	}
+	mindist := func(K [][]int) (dmin float64) {
+		dmin = math.MaxFloat64
+		for i := 0; i < n; i++ {
+			for k := i + 1; k < n; k++ {
+				d := 0.0
+				for j := 0; j < dim; j++ {
+					d += math.Pow(float64(K[j][i]-K[j][k]), 2)
+				}
+				dmin = math.Min(dmin, math.Sqrt(d))
+			}
+		}
+		return
+	}
+	K := LatinMaximin(dim, n, 5000)
+	io.Pforan("K = %v\n", K)
+	for j := 0; j < dim; j++ {
+		count := make([]int, n)
+		for i := 0; i < n; i++ {
+			count[K[j][i]-1]++
+		}
+		chk.Ints(tst, "Latin", count, ones)
+	}
+	io.Pforan("min distance = %g\n", mindist(K))
+	if mindist(K) < math.Sqrt(5) {
+		tst.Errorf("min distance %g is too small\n", mindist(K))
+	}
+}
+
+func Test_sampling03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sampling03. opposition-based initialisation")
+
+	for _, gentype := range []string{"sobol", "maximin", "opposition"} {
+
+		// parameters
+		var opt Optimiser
+		opt.Default()
+		opt.Nsol = 20
+		opt.Ncpu = 2
+		opt.Verbose = false
+		opt.GenType = gentype
+		opt.FltMin = []float64{-1, -1}
+		opt.FltMax = []float64{3, 3}
+		opt.IntMin = []int{0, 0}
+		opt.IntMax = []int{9, 9}
+		nf, ng, nh := 1, 0, 0
+
+		// initialise optimiser
+		opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+			f[0] = x[0]*x[0] + x[1]*x[1] + float64(ξ[0]+ξ[1])
+		}, nf, ng, nh)
+
+		// check
+		io.Pforan("%-10s nfeval = %d\n", gentype, opt.Nfeval)
+		for _, sol := range opt.Solutions {
+			for i, x := range sol.Flt {
+				if x < opt.FltMin[i] || x > opt.FltMax[i] {
+					tst.Errorf("x = %g is out of range\n", x)
+					return
+				}
+			}
+			for i, y := range sol.Int {
+				if y < opt.IntMin[i] || y > opt.IntMax[i] {
+					tst.Errorf("y = %d is out of range\n", y)
+					return
+				}
+			}
+		}
+		if gentype != "opposition" {
+			chk.IntAssert(opt.Nfeval, opt.Nsol)
+			continue
+		}
+		chk.IntAssert(opt.Nfeval, 2*opt.Nsol)
+	}
+}
+
+func Test_sampling04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sampling04. generation of a single int or categorical int")
+
+	for _, cat := range []bool{false, true} {
+		for _, gentype := range []string{"latin", "halton", "sobol", "maximin", "rnd"} {
+
+			// parameters
+			var opt Optimiser
+			opt.Default()
+			opt.Nsol = 20
+			opt.Ncpu = 2
+			opt.Tf = 10
+			opt.Verbose = false
+			opt.GenType = gentype
+			opt.IntMin = []int{0}
+			opt.IntMax = []int{9}
+			if cat {
+				opt.IntMin, opt.IntMax = nil, nil
+				opt.IntCats = [][]string{{"steel", "timber", "concrete"}}
+			}
+			nf, ng, nh := 1, 0, 0
+
+			// initialise optimiser
+			opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+				f[0] = float64(ξ[0])
+			}, nf, ng, nh)
+
+			// check
+			count := make(map[int]int)
+			for _, sol := range opt.Solutions {
+				y := sol.Int[0]
+				if y < opt.IntMin[0] || y > opt.IntMax[0] {
+					tst.Errorf("y = %d is out of range\n", y)
+					return
+				}
+				count[y]++
+			}
+			io.Pforan("cat=%-5v %-8s count = %v\n", cat, gentype, count)
+			if len(count) < 2 {
+				tst.Errorf("single int must be generated (%s). count = %v\n", gentype, count)
+				return
+			}
+
+			// solve
+			opt.Solve()
+			SortByOva(opt.Solutions, 0)
+			chk.IntAssert(opt.Solutions[0].Int[0], 0)
+		}
+	}
+}
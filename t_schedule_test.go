@@ -0,0 +1,144 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_sched01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sched01. schedules")
+
+	lin := &Schedule{Type: "linear", V0: 0.9, V1: 0.1}
+	chk.Scalar(tst, "lin(0)  ", 1e-15, lin.Value(0), 0.9)
+	chk.Scalar(tst, "lin(0.5)", 1e-15, lin.Value(0.5), 0.5)
+	chk.Scalar(tst, "lin(2)  ", 1e-15, lin.Value(2), 0.1)
+
+	exp := &Schedule{Type: "exp", V0: 1, V1: 1e-4}
+	chk.Scalar(tst, "exp(0)  ", 1e-15, exp.Value(0), 1)
+	chk.Scalar(tst, "exp(0.5)", 1e-15, exp.Value(0.5), 1e-2)
+	chk.Scalar(tst, "exp(1)  ", 1e-15, exp.Value(1), 1e-4)
+
+	stp := &Schedule{Type: "step", V0: 0.5, Tau: []float64{0.25, 0.75}, Vals: []float64{0.3, 0.1}}
+	chk.Scalar(tst, "stp(0.2)", 1e-15, stp.Value(0.2), 0.5)
+	chk.Scalar(tst, "stp(0.5)", 1e-15, stp.Value(0.5), 0.3)
+	chk.Scalar(tst, "stp(0.8)", 1e-15, stp.Value(0.8), 0.1)
+
+	cos := &Schedule{Type: "cos", V0: 1, V1: 0}
+	chk.Scalar(tst, "cos(0)  ", 1e-15, cos.Value(0), 1)
+	chk.Scalar(tst, "cos(0.5)", 1e-15, cos.Value(0.5), 0.5)
+	chk.Scalar(tst, "cos(1)  ", 1e-15, cos.Value(1), 0)
+
+	fcn := &Schedule{Type: "fcn", Fcn: func(τ float64) float64 { return math.Sqrt(τ) }}
+	chk.Scalar(tst, "fcn(0.25)", 1e-15, fcn.Value(0.25), 0.5)
+}
+
+func Test_sched02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sched02. time-varying parameters in Solve")
+
+	// parameters from JSON
+	var opt Optimiser
+	opt.Default()
+	err := json.Unmarshal([]byte(`{
+		"Nsol" : 20, "Ncpu" : 2, "Tf" : 100, "DtExc" : 10, "Verbose" : false,
+		"FltMin" : [-2, -2], "FltMax" : [2, 2],
+		"Sched" : {
+			"DEC"  : { "Type" : "linear", "V0" : 0.9, "V1" : 0.4 },
+			"EpsH" : { "Type" : "exp",    "V0" : 0.1, "V1" : 1e-4 }
+		}
+	}`), &opt.Parameters)
+	if err != nil {
+		tst.Errorf("cannot unmarshal parameters:\n%v\n", err)
+		return
+	}
+	io.Pforan("%v\n", opt.Sched["DEC"])
+	nf, ng, nh := 1, 0, 1
+
+	// initialise optimiser
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0]*x[0] + x[1]*x[1]
+		h[0] = x[0] + x[1] - 1
+	}, nf, ng, nh)
+	chk.Scalar(tst, "EpsH(0)", 1e-15, opt.EpsH, 0.1)
+
+	// solve
+	opt.Solve()
+	io.Pforan("times = %v\n", opt.SchedTimes)
+	io.Pforan("DEC   = %v\n", opt.SchedVals["DEC"])
+	io.Pforan("EpsH  = %v\n", opt.SchedVals["EpsH"])
+	chk.Ints(tst, "times", opt.SchedTimes, []int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100})
+	chk.Scalar(tst, "DEC(50) ", 1e-15, opt.SchedVals["DEC"][5], 0.65)
+	chk.Scalar(tst, "DEC(100)", 1e-15, opt.DEC, 0.4)
+	chk.Scalar(tst, "EpsH(50)", 1e-15, opt.SchedVals["EpsH"][5], math.Sqrt(0.1*1e-4))
+
+	// out-of-range values of all solutions correspond to the final EpsH
+	for _, sol := range opt.Solutions {
+		chk.Scalar(tst, "oor", 1e-15, sol.Oor[0], math.Max(0, sol.Habs[0]-opt.EpsH))
+	}
+
+	// best solution satisfies the tight equality constraint
+	SortByOva(opt.Solutions, 0)
+	best := opt.Solutions[0]
+	io.Pforan("best = %v\n", best.Flt)
+	chk.Vector(tst, "best", 1e-2, best.Flt, []float64{0.5, 0.5})
+}
+
+func Test_sched03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sched03. invalid schedules are rejected")
+
+	// parameters
+	newPrms := func(sch *Schedule) (prms *Parameters) {
+		prms = new(Parameters)
+		prms.Default()
+		prms.Nova = 1
+		prms.FltMin = []float64{-1, -1}
+		prms.FltMax = []float64{1, 1}
+		prms.Sched = map[string]*Schedule{"DEC": sch}
+		return
+	}
+	invalid := []*Schedule{
+		{Type: "exp", V0: 0, V1: 1},
+		{Type: "step", V0: 0.5, Tau: []float64{0.5}, Vals: []float64{0.1, 0.2}},
+		{Type: "step", V0: 0.5, Tau: []float64{0.75, 0.25}, Vals: []float64{0.1, 0.2}},
+		{Type: "fcn"},
+		{Type: "quadratic"},
+		{Type: "linear", V0: 0.9, V1: 1.5},
+		{Type: "exp", V0: 2, V1: 0.5},
+		{Type: "cos", V0: -0.2, V1: 0.5},
+		{Type: "step", V0: 0.5, Tau: []float64{0.5}, Vals: []float64{-0.1}},
+	}
+	for _, sch := range invalid {
+
+		// Validate reports the problem
+		err := newPrms(sch).Validate()
+		io.Pforan("%v\n", err)
+		if err == nil || !strings.Contains(err.Error(), "DEC") {
+			tst.Errorf("Validate should have failed with schedule %v\n", sch)
+			return
+		}
+
+		// CalcDerived panics
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					tst.Errorf("CalcDerived should have panicked with schedule %v\n", sch)
+				}
+			}()
+			newPrms(sch).CalcDerived()
+		}()
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_seed01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("seed01. seeding with user points")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 20
+	opt.Ncpu = 2
+	opt.Tf = 10
+	opt.Verbose = false
+	opt.FltMin = []float64{-2, -2}
+	opt.FltMax = []float64{2, 2}
+	opt.SeedFlt = [][]float64{{1, 1}, {0.5, 0.5}, {3, -3}}
+	nf, ng, nh := 1, 0, 0
+
+	// initialise optimiser
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = (x[0]-1)*(x[0]-1) + (x[1]-1)*(x[1]-1)
+	}, nf, ng, nh)
+
+	// check seeds: spread over groups and within range
+	chk.IntAssert(opt.NumSeed(), 3)
+	io.Pforan("seeds = %v %v %v\n", opt.Solutions[0].Flt, opt.Solutions[6].Flt, opt.Solutions[13].Flt)
+	chk.Bool(tst, "seed 0", opt.Solutions[0].Seed, true)
+	chk.Bool(tst, "seed 1", opt.Solutions[6].Seed, true)
+	chk.Bool(tst, "seed 2", opt.Solutions[13].Seed, true)
+	chk.Vector(tst, "x0", 1e-15, opt.Solutions[0].Flt, []float64{1, 1})
+	chk.Vector(tst, "x1", 1e-15, opt.Solutions[6].Flt, []float64{0.5, 0.5})
+	chk.Vector(tst, "x2", 1e-15, opt.Solutions[13].Flt, []float64{2, -2})
+	chk.Scalar(tst, "f0", 1e-15, opt.Solutions[0].Ova[0], 0)
+
+	// the optimal seed cannot be beaten
+	opt.Solve()
+	SortByOva(opt.Solutions, 0)
+	chk.Bool(tst, "best is seed", opt.Solutions[0].Seed, true)
+	io.Pforan("number of remaining seeds = %d\n", opt.NumSeed())
+}
+
+func Test_seed02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("seed02. warm-start from results file")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 6
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.FltMin = []float64{-1, 0}
+	opt.FltMax = []float64{1, 3}
+	opt.IntMin = []int{0, 0}
+	opt.IntMax = []int{5, 0}
+	opt.IntCats = [][]string{nil, {"steel", "timber", "concrete"}}
+	nf, ng, nh := 2, 1, 0
+
+	// initialise optimiser and write results
+	fcn := func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0] + float64(ξ[0])
+		f[1] = x[1] + float64(ξ[1])
+		g[0] = x[0]
+	}
+	opt.Init(GenTrialSolutions, nil, fcn, nf, ng, nh)
+	WriteAllValues("/tmp/goga", "seed02", &opt)
+
+	// read results
+	flt, ints, err := ReadAllValues("/tmp/goga/seed02.res", &opt.Parameters)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for i, sol := range opt.Solutions {
+		chk.Vector(tst, "flt", 1e-15, flt[i], sol.Flt)
+		chk.Ints(tst, "int", ints[i], sol.Int)
+	}
+
+	// warm-start
+	var opt2 Optimiser
+	opt2.Default()
+	opt2.Nsol = 6
+	opt2.Ncpu = 1
+	opt2.Verbose = false
+	opt2.FltMin, opt2.FltMax = opt.FltMin, opt.FltMax
+	opt2.IntMin, opt2.IntMax = opt.IntMin, opt.IntMax
+	opt2.IntCats = opt.IntCats
+	opt2.SeedFile = "/tmp/goga/seed02.res"
+	opt2.Init(GenTrialSolutions, nil, fcn, nf, ng, nh)
+	chk.IntAssert(opt2.NumSeed(), 6)
+	for i, sol := range opt2.Solutions {
+		chk.Vector(tst, "flt", 1e-15, sol.Flt, opt.Solutions[i].Flt)
+		chk.Ints(tst, "int", sol.Int, opt.Solutions[i].Int)
+	}
+}
+
+func Test_seed03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("seed03. labels with spaces and invalid seeds")
+
+	// parameters
+	newOpt := func() (opt *Optimiser) {
+		opt = new(Optimiser)
+		opt.Default()
+		opt.Nsol = 6
+		opt.Ncpu = 1
+		opt.Verbose = false
+		opt.FltMin = []float64{-1}
+		opt.FltMax = []float64{1}
+		opt.IntMin = []int{0}
+		opt.IntMax = []int{0}
+		opt.IntCats = [][]string{{"reinforced concrete", "steel", "\"glulam\" timber"}}
+		return
+	}
+	fcn := func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0] + float64(ξ[0])
+	}
+
+	// labels with spaces are quoted
+	opt := newOpt()
+	opt.Init(GenTrialSolutions, nil, fcn, 1, 0, 0)
+	for i, sol := range opt.Solutions {
+		sol.Int[0] = i % 3
+	}
+	WriteAllValues("/tmp/goga", "seed03", opt)
+	flt, ints, err := ReadAllValues("/tmp/goga/seed03.res", &opt.Parameters)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for i, sol := range opt.Solutions {
+		chk.Vector(tst, "flt", 1e-15, flt[i], sol.Flt)
+		chk.Ints(tst, "int", ints[i], sol.Int)
+	}
+
+	// invalid values are reported with line and column
+	io.WriteFileSD("/tmp/goga", "seed03bad.res", "front f0 x0 y0\n0 1 0.5 steel\n0 1 abc steel\n")
+	_, _, err = ReadAllValues("/tmp/goga/seed03bad.res", &opt.Parameters)
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "line 3, column 3") {
+		tst.Errorf("ReadAllValues should have failed at line 3, column 3\n")
+	}
+	io.WriteFileSD("/tmp/goga", "seed03bad.res", "front f0 x0 y0\n0 1 0.5 \"steel\n")
+	_, _, err = ReadAllValues("/tmp/goga/seed03bad.res", &opt.Parameters)
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		tst.Errorf("ReadAllValues should have failed with unterminated quote at line 2\n")
+	}
+
+	// invalid seeds
+	opt = newOpt()
+	opt.SeedFlt = [][]float64{{0.5}, {0.5, 0.5}}
+	err = opt.InitErr(GenTrialSolutions, nil, fcn, 1, 0, 0)
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "SeedFlt[1]") {
+		tst.Errorf("InitErr should have failed with invalid length of seed\n")
+	}
+	opt = new(Optimiser)
+	opt.Default()
+	opt.Nsol = 6
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.PermInt = 4
+	opt.SeedInt = [][]int{{0, 1, 2, 3}, {0, 1, 1, 3}}
+	err = opt.InitErr(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = float64(ξ[0])
+	}, 1, 0, 0)
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "permutation") {
+		tst.Errorf("InitErr should have failed with invalid permutation\n")
+	}
+}
+
+func Test_seed04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("seed04. results file and seeds with variable-length chromosomes")
+
+	// parameters
+	newOpt := func() *Optimiser {
+		var opt Optimiser
+		opt.Default()
+		opt.Nsol = 6
+		opt.Ncpu = 1
+		opt.Verbose = false
+		opt.VarLen = true
+		opt.LenMin = 1
+		opt.LenMax = 4
+		opt.FltMin = []float64{0, 0}
+		opt.FltMax = []float64{10, 10}
+		opt.IntMin = []int{0}
+		opt.IntMax = []int{9}
+		return &opt
+	}
+	fcn := func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = float64(len(x))
+	}
+
+	// initialise optimiser and write results
+	opt := newOpt()
+	opt.SeedFlt = [][]float64{{1, 2}, {1, 2, 3, 4, 5, 6, 7, 8}}
+	opt.SeedInt = [][]int{{1}, {1, 2, 3, 4}}
+	opt.Init(GenTrialSolutions, nil, fcn, 1, 0, 0)
+	chk.IntAssert(opt.NumSeed(), 2)
+	chk.Vector(tst, "seed 1", 1e-15, opt.Solutions[3].Flt, opt.SeedFlt[1])
+	chk.Ints(tst, "seed 1", opt.Solutions[3].Int, opt.SeedInt[1])
+	WriteAllValues("/tmp/goga", "seed04", opt)
+
+	// read results
+	flt, ints, err := ReadAllValues("/tmp/goga/seed04.res", &opt.Parameters)
+	if err != nil {
+		tst.Errorf("%v\n", err)
+		return
+	}
+	for i, sol := range opt.Solutions {
+		chk.Vector(tst, "flt", 1e-15, flt[i], sol.Flt)
+		chk.Ints(tst, "int", ints[i], sol.Int)
+	}
+
+	// warm-start
+	opt2 := newOpt()
+	opt2.SeedFile = "/tmp/goga/seed04.res"
+	opt2.Init(GenTrialSolutions, nil, fcn, 1, 0, 0)
+	chk.IntAssert(opt2.NumSeed(), 6)
+	for i, sol := range opt2.Solutions {
+		chk.Vector(tst, "flt", 1e-15, sol.Flt, opt.Solutions[i].Flt)
+		chk.Ints(tst, "int", sol.Int, opt.Solutions[i].Int)
+	}
+
+	// invalid seeds
+	for _, seed := range []struct {
+		flt  []float64
+		ints []int
+	}{
+		{[]float64{1, 2, 3}, []int{1}},                  // incomplete gene
+		{[]float64{1, 2, 3, 4}, []int{1}},               // numbers of genes differ
+		{[]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, nil}, // too many genes and no ints
+	} {
+		opt3 := newOpt()
+		opt3.SeedFlt = [][]float64{seed.flt}
+		if seed.ints != nil {
+			opt3.SeedInt = [][]int{seed.ints}
+		}
+		err = opt3.InitErr(GenTrialSolutions, nil, fcn, 1, 0, 0)
+		io.Pforan("%v\n", err)
+		if err == nil {
+			tst.Errorf("InitErr should have failed with seed %v %v\n", seed.flt, seed.ints)
+			return
+		}
+	}
+
+	// invalid results files: without number of genes or with too many genes
+	io.WriteFileSD("/tmp/goga", "seed04bad.res", "front f0 x0 x1 y0\n0 1 0.5 0.5 1\n")
+	_, _, err = ReadAllValues("/tmp/goga/seed04bad.res", &opt.Parameters)
+	io.Pforan("%v\n", err)
+	if err == nil {
+		tst.Errorf("ReadAllValues should have failed without column ngene\n")
+		return
+	}
+	io.WriteFileSD("/tmp/goga", "seed04bad.res", "front ngene f0 x0 x1 x2 x3 x4 x5 x6 x7 y0 y1 y2 y3\n0 5 1 0 0 0 0 0 0 0 0 0 0 0 0\n")
+	_, _, err = ReadAllValues("/tmp/goga/seed04bad.res", &opt.Parameters)
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "line 2, column 2") {
+		tst.Errorf("ReadAllValues should have failed at line 2, column 2\n")
+	}
+}
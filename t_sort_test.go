@@ -0,0 +1,213 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func getIds(sols []*Solution) (ids []int) {
+	ids = make([]int, len(sols))
+	for i, sol := range sols {
+		ids[i] = sol.Id
+	}
+	return
+}
+
+func Test_sort01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sort01. sort by many objective values")
+
+	// parameters
+	var prms Parameters
+	prms.Default()
+	prms.Nova = 25
+	prms.Noor = 1
+	prms.FltMin = []float64{0}
+	prms.FltMax = []float64{1}
+	prms.CalcDerived()
+
+	// solutions
+	sols := NewSolutions(4, &prms)
+	for i, sol := range sols {
+		sol.Ova[22] = float64(10 - i)
+	}
+
+	// sort by ova beyond the old limit of 20
+	SortByOva(sols, 22)
+	chk.Ints(tst, "ids", getIds(sols), []int{3, 2, 1, 0})
+
+	// sort by front, then violation, then ova
+	sols[0].FrontId, sols[1].FrontId, sols[2].FrontId, sols[3].FrontId = 1, 0, 1, 0
+	sols[0].Oor[0], sols[1].Oor[0], sols[2].Oor[0], sols[3].Oor[0] = 1, 2, 0, 2
+	SortSolutions(sols, SortKey{Type: KeyFront}, SortKey{Type: KeyOor}, SortKey{Type: KeyOva, Idx: 22})
+	chk.Ints(tst, "ids", getIds(sols), []int{2, 0, 1, 3})
+
+	// sort by front then ova (any index)
+	SortByFrontThenOva(sols, 22)
+	chk.Ints(tst, "ids", getIds(sols), []int{2, 0, 3, 1})
+
+	// crowd distance is descending
+	sols[0].DistCrowd, sols[1].DistCrowd, sols[2].DistCrowd, sols[3].DistCrowd = 1, 3, 2, 4
+	SortSolutions(sols, SortKey{Type: KeyCrowd})
+	chk.Ints(tst, "ids", getIds(sols), []int{1, 0, 3, 2})
+}
+
+func Test_sort02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sort02. lexicographic comparisons")
+
+	// parameters
+	var prms Parameters
+	prms.Default()
+	prms.Nova = 3
+	prms.Lexico = true
+	prms.LexPrio = []int{2, 0, 1}
+	prms.LexTols = []float64{0, 0, 0.5}
+	prms.FltMin = []float64{0}
+	prms.FltMax = []float64{1}
+	prms.CalcDerived()
+
+	// solutions
+	A := NewSolution(0, 0, &prms)
+	B := NewSolution(1, 0, &prms)
+
+	// f2 decides
+	copy(A.Ova, []float64{5, 5, 1})
+	copy(B.Ova, []float64{0, 0, 2})
+	Adom, Bdom := A.Compare(B)
+	chk.Bool(tst, "A dominates", Adom, true)
+	chk.Bool(tst, "B dominates", Bdom, false)
+
+	// f2 within tolerance => f0 decides
+	copy(B.Ova, []float64{0, 9, 1.4})
+	Adom, Bdom = A.Compare(B)
+	chk.Bool(tst, "A dominates", Adom, false)
+	chk.Bool(tst, "B dominates", Bdom, true)
+
+	// tie
+	copy(B.Ova, []float64{5, 5, 1.2})
+	Adom, Bdom = A.Compare(B)
+	chk.Bool(tst, "A dominates", Adom, false)
+	chk.Bool(tst, "B dominates", Bdom, false)
+
+	// sorting with lexicographic keys
+	sols := []*Solution{A, B, NewSolution(2, 0, &prms)}
+	copy(sols[2].Ova, []float64{-1, 0, 0})
+	SortSolutions(sols, prms.LexKeys()...)
+	chk.Ints(tst, "ids", getIds(sols), []int{2, 0, 1})
+}
+
+func Test_sort03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sort03. sorting with tolerances does not depend on input order")
+
+	// parameters
+	var prms Parameters
+	prms.Default()
+	prms.Nova = 2
+	prms.FltMin = []float64{0}
+	prms.FltMax = []float64{1}
+	prms.CalcDerived()
+
+	// f0 = 0, 0.4, 0.8 => a≈b and b≈c but a≉c; f1 decides ties
+	sols := NewSolutions(3, &prms)
+	for i, f := range [][]float64{{0, 3}, {0.4, 1}, {0.8, 2}} {
+		copy(sols[i].Ova, f)
+	}
+	keys := []SortKey{{Type: KeyOva, Idx: 0, Tol: 0.5}, {Type: KeyOva, Idx: 1}}
+	for _, order := range [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}} {
+		s := []*Solution{sols[order[0]], sols[order[1]], sols[order[2]]}
+		SortSolutions(s, keys...)
+		chk.Ints(tst, "ids", getIds(s), []int{1, 0, 2})
+	}
+}
+
+func Test_sort04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sort04. lexicographic tournaments agree with sorting")
+
+	// parameters
+	var prms Parameters
+	prms.Default()
+	prms.Nova = 2
+	prms.Lexico = true
+	prms.LexTols = []float64{0.5, 0}
+	prms.FltMin = []float64{0}
+	prms.FltMax = []float64{1}
+	prms.CalcDerived()
+
+	// f0 = 0, 0.4, 0.8 => pairwise comparisons with tolerances are cyclic: 0 > 2 > 1 > 0
+	F := [][]float64{{0, 3}, {0.4, 1}, {0.8, 0}}
+	sols := NewSolutions(3, &prms)
+	for i, f := range F {
+		copy(sols[i].Ova, f)
+	}
+	A_wins, _ := prms.LexCompare(F[2], F[1])
+	chk.Bool(tst, "pairwise: 2 beats 1", A_wins, true)
+
+	// comparisons of buckets computed by metrics
+	var m Metrics
+	m.Init(len(sols), &prms)
+	m.Compute(sols)
+	for _, pair := range [][]int{{1, 0}, {0, 2}, {1, 2}} {
+		A_dom, B_dom := sols[pair[0]].Compare(sols[pair[1]])
+		chk.Bool(tst, io.Sf("%d beats %d", pair[0], pair[1]), A_dom && !B_dom, true)
+	}
+
+	// sorting
+	s := []*Solution{sols[2], sols[0], sols[1]}
+	SortSolutions(s, prms.LexKeys()...)
+	chk.Ints(tst, "ids", getIds(s), []int{1, 0, 2})
+
+	// best of many points does not depend on order
+	for _, order := range [][]int{{0, 1, 2}, {2, 0, 1}, {1, 2, 0}} {
+		G := [][]float64{F[order[0]], F[order[1]], F[order[2]]}
+		chk.IntAssert(order[prms.LexBest(G)], 1)
+	}
+}
+
+func Test_sort05(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("sort05. lexicographic comparison of re-evaluated copies")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 10
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.Lexico = true
+	opt.FltMin = []float64{0, 0}
+	opt.FltMax = []float64{1, 1}
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0], f[1] = x[0], x[1]
+	}, 2, 0, 0)
+
+	// buckets of original solution
+	A := opt.Solutions[0]
+	A.Flt[0], A.Flt[1] = 0.5, 0.5
+	opt.ObjFunc(A, 0)
+	opt.Metrics.Compute(opt.Solutions)
+	chk.Vector(tst, "buckets of A", 1e-15, A.LexBkt, []float64{0.5, 0.5})
+
+	// modified and re-evaluated copy
+	B := NewSolution(-1, 0, &opt.Parameters)
+	A.CopyInto(B)
+	B.Flt[0] = 0.25
+	opt.ObjFunc(B, 0)
+	io.Pforan("B: ova = %v  buckets = %v\n", B.Ova, B.LexBkt)
+	chk.IntAssert(len(B.LexBkt), 0)
+	B_dom, A_dom := B.Compare(A)
+	chk.Bool(tst, "B dominates A", B_dom && !A_dom, true)
+}
@@ -0,0 +1,222 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+)
+
+func Test_validate01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("validate01. all problems at once")
+
+	var prms Parameters
+	prms.Default()
+	prms.Nova = 1
+	prms.Nsol = 10
+	prms.Ncpu = 8
+	prms.GenType = "sobel"
+	prms.FltMin = []float64{0, 2, 0}
+	prms.FltMax = []float64{1, 1, 1}
+	prms.IntMin = []int{0, 0}
+	prms.IntMax = []int{1}
+	err := prms.Validate()
+	if err == nil {
+		tst.Errorf("Validate should have failed\n")
+		return
+	}
+	io.Pforan("%v\n", err)
+	for _, msg := range []string{"4 problem(s)", "Ncpu=8", "FltMin[1]", "IntMin and IntMax", `"sobel"`} {
+		if !strings.Contains(err.Error(), msg) {
+			tst.Errorf("error message should contain %q\n", msg)
+		}
+	}
+
+	// fixed
+	prms.Ncpu = 2
+	prms.GenType = "sobol"
+	prms.FltMin[1] = 0
+	prms.IntMax = []int{1, 1}
+	err = prms.Validate()
+	if err != nil {
+		tst.Errorf("Validate failed:\n%v\n", err)
+	}
+}
+
+func Test_validate02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("validate02. strict decoder")
+
+	var prms Parameters
+	prms.Default()
+	err := prms.DecodeStrict([]byte(`{ "Nsoll" : 10, "ncpu" : 2, "GenTipe" : "rnd", "FooBar" : 1 }`))
+	if err == nil {
+		tst.Errorf("DecodeStrict should have failed\n")
+		return
+	}
+	io.Pforan("%v\n", err)
+	for _, msg := range []string{`"Nsoll" (did you mean "Nsol"?)`, `"GenTipe" (did you mean "GenType"?)`, `"FooBar"`} {
+		if !strings.Contains(err.Error(), msg) {
+			tst.Errorf("error message should contain %q\n", msg)
+		}
+	}
+	if strings.Contains(err.Error(), `"ncpu"`) {
+		tst.Errorf("keys are case-insensitive\n")
+	}
+
+	// nested keys and wrong types
+	err = prms.DecodeStrict([]byte(`{ "Sched" : { "DEC" : { "Type" : "linear", "V2" : 1 } } }`))
+	io.Pforan("%v\n", err)
+	if err == nil {
+		tst.Errorf("DecodeStrict should have failed with unknown nested key\n")
+	}
+	err = prms.DecodeStrict([]byte(`{ "Nsol" : "ten" }`))
+	io.Pforan("%v\n", err)
+	if err == nil {
+		tst.Errorf("DecodeStrict should have failed with wrong type\n")
+	}
+
+	// success
+	err = prms.DecodeStrict([]byte(`{ "Nsol" : 10, "ncpu" : 2 }`))
+	if err != nil {
+		tst.Errorf("DecodeStrict failed:\n%v\n", err)
+		return
+	}
+	chk.IntAssert(prms.Nsol, 10)
+	chk.IntAssert(prms.Ncpu, 2)
+
+	// missing file
+	err = prms.ReadErr("/tmp/goga/__inexistent__.json")
+	if err == nil {
+		tst.Errorf("ReadErr should have failed\n")
+	}
+}
+
+func Test_validate03(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("validate03. error-returning Init")
+
+	fcn := func(f, g, h, x []float64, ξ []int, cpu int) {
+		f[0] = x[0]*x[0] + x[1]*x[1]
+	}
+
+	// invalid
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 4
+	opt.Verbose = false
+	opt.FltMin = []float64{-1, -1}
+	opt.FltMax = []float64{1}
+	err := opt.InitErr(GenTrialSolutions, nil, fcn, 1, 0, 0)
+	io.Pforan("%v\n", err)
+	if err == nil {
+		tst.Errorf("InitErr should have failed\n")
+		return
+	}
+
+	// valid
+	opt.Default()
+	opt.Nsol = 10
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.FltMin = []float64{-1, -1}
+	opt.FltMax = []float64{1, 1}
+	err = opt.InitErr(GenTrialSolutions, nil, fcn, 1, 0, 0)
+	if err != nil {
+		tst.Errorf("InitErr failed:\n%v\n", err)
+		return
+	}
+	chk.IntAssert(len(opt.Solutions), 10)
+
+	// failure during initialisation
+	opt.Default()
+	opt.Nsol = 10
+	opt.Ncpu = 1
+	opt.Verbose = false
+	opt.FltMin = []float64{-1, -1}
+	opt.FltMax = []float64{1, 1}
+	opt.SeedFile = "/tmp/goga/__inexistent__.res"
+	err = opt.InitErr(GenTrialSolutions, nil, fcn, 1, 0, 0)
+	io.Pforan("%v\n", err)
+	if err == nil {
+		tst.Errorf("InitErr should have failed with inexistent seed file\n")
+	}
+
+	// failure in objective function is not a configuration error
+	opt.Default()
+	opt.Nsol = 10
+	opt.Ncpu = 1
+	opt.GenAll = true
+	opt.Verbose = false
+	opt.FltMin = []float64{-1, -1}
+	opt.FltMax = []float64{1, 1}
+	err = opt.InitErr(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		chk.Panic("objective failed")
+	}, 1, 0, 0)
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "generation of initial solutions failed") {
+		tst.Errorf("InitErr should have failed with generation error\n")
+	}
+}
+
+func Test_validate04(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("validate04. CalcDerived uses Validate")
+
+	var prms Parameters
+	prms.Default()
+	prms.Nova = 1
+	prms.DEC = 1.2
+	prms.FltMin = []float64{0, 2}
+	prms.FltMax = []float64{1, 1}
+	defer func() {
+		r := recover()
+		io.Pforan("%v\n", r)
+		if r == nil {
+			tst.Errorf("CalcDerived should have panicked\n")
+			return
+		}
+		for _, msg := range []string{"2 problem(s)", "DEC=1.2", "FltMin[1]"} {
+			if !strings.Contains(io.Sf("%v", r), msg) {
+				tst.Errorf("panic message should contain %q\n", msg)
+			}
+		}
+	}()
+	prms.CalcDerived()
+}
+
+func Test_validate05(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("validate05. meshes require GenAll")
+
+	var prms Parameters
+	prms.Default()
+	prms.Nova = 1
+	prms.UseMesh = true
+	prms.FltMin = []float64{-1, -1}
+	prms.FltMax = []float64{1, 1}
+	err := prms.Validate()
+	io.Pforan("%v\n", err)
+	if err == nil || !strings.Contains(err.Error(), "GenAll") {
+		tst.Errorf("Validate should have failed with UseMesh and GenAll = false\n")
+		return
+	}
+
+	// fixed
+	prms.GenAll = true
+	err = prms.Validate()
+	if err != nil {
+		tst.Errorf("Validate failed:\n%v\n", err)
+	}
+}
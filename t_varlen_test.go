@@ -0,0 +1,129 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"testing"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/rnd"
+)
+
+func Test_varlen01(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("varlen01. operators for variable-length chromosomes")
+
+	// parameters
+	var prms Parameters
+	prms.Default()
+	prms.Ncpu = 1
+	prms.VarLen = true
+	prms.LenMin = 1
+	prms.LenMax = 6
+	prms.FltMin = []float64{0, 0}
+	prms.FltMax = []float64{10, 10}
+	prms.IntMin = []int{0}
+	prms.IntMax = []int{9}
+	prms.CalcDerived()
+
+	// parents
+	A := NewSolution(0, 0, &prms)
+	B := NewSolution(1, 0, &prms)
+	A.Flt, A.Int = A.Flt[:10], A.Int[:5]
+	B.Flt, B.Int = B.Flt[:6], B.Int[:3]
+	copy(A.Flt, []float64{0, 0, 1, 1, 2, 2, 3, 3, 4, 4})
+	copy(A.Int, []int{0, 1, 2, 3, 4})
+	copy(B.Flt, []float64{5, 5, 6, 6, 7, 7})
+	copy(B.Int, []int{5, 6, 7})
+	chk.IntAssert(A.Ngenes(), 5)
+	chk.IntAssert(B.Ngenes(), 3)
+
+	// cut-and-splice
+	a := NewSolution(2, 0, &prms)
+	b := NewSolution(3, 0, &prms)
+	cxVarLen(a, b, A, B, 3, 1)
+	io.Pforan("a = %v %v\n", a.Flt, a.Int)
+	io.Pforan("b = %v %v\n", b.Flt, b.Int)
+	chk.Vector(tst, "a.Flt", 1e-15, a.Flt, []float64{0, 0, 1, 1, 2, 2, 6, 6, 7, 7})
+	chk.Ints(tst, "a.Int", a.Int, []int{0, 1, 2, 6, 7})
+	chk.Vector(tst, "b.Flt", 1e-15, b.Flt, []float64{5, 5, 3, 3, 4, 4})
+	chk.Ints(tst, "b.Int", b.Int, []int{5, 3, 4})
+
+	// insert and delete genes
+	mtVarLenIns(b, 1)
+	b.Flt[2], b.Flt[3], b.Int[1] = 8, 8, 8
+	chk.Vector(tst, "b.Flt", 1e-15, b.Flt, []float64{5, 5, 8, 8, 3, 3, 4, 4})
+	chk.Ints(tst, "b.Int", b.Int, []int{5, 8, 3, 4})
+	mtVarLenDel(b, 0)
+	chk.Vector(tst, "b.Flt", 1e-15, b.Flt, []float64{8, 8, 3, 3, 4, 4})
+	chk.Ints(tst, "b.Int", b.Int, []int{8, 3, 4})
+
+	// distance: one gene equal, one different and three missing
+	fmin, fmax := []float64{0, 0}, []float64{10, 10}
+	imin, imax := []int{0}, []int{9}
+	copy(b.Flt, []float64{0, 0, 1, 1})
+	b.Flt, b.Int = b.Flt[:4], b.Int[:2]
+	b.Int[0], b.Int[1] = 0, 1
+	chk.Scalar(tst, "dist(A,A)", 1e-15, A.Distance(A, fmin, fmax, imin, imax), 0)
+	chk.Scalar(tst, "dist(A,b)", 1e-15, A.Distance(b, fmin, fmax, imin, imax), 3.0/5.0)
+	chk.Scalar(tst, "dist(b,A)", 1e-15, b.Distance(A, fmin, fmax, imin, imax), 3.0/5.0)
+
+	// random operators keep lengths within bounds
+	rnd.Init(0)
+	prms.VarPins, prms.VarPdel = 0.5, 0.5
+	for k := 0; k < 100; k++ {
+		CxVarLen(a, b, A, B, &prms)
+		MtVarLen(a, &prms)
+		DiffEvolVarLen(b, A, a, B, &prms)
+		for _, s := range []*Solution{a, b} {
+			n := s.Ngenes()
+			if n < prms.LenMin || n > prms.LenMax || len(s.Int) != n {
+				tst.Errorf("number of genes %d is invalid\n", n)
+				return
+			}
+		}
+	}
+}
+
+func Test_varlen02(tst *testing.T) {
+
+	//verbose()
+	chk.PrintTitle("varlen02. unknown number of components")
+
+	// parameters
+	var opt Optimiser
+	opt.Default()
+	opt.Nsol = 30
+	opt.Ncpu = 1
+	opt.Tf = 200
+	opt.Verbose = false
+	opt.VarLen = true
+	opt.LenMin = 1
+	opt.LenMax = 8
+	opt.FltMin = []float64{-1}
+	opt.FltMax = []float64{3}
+	nf, ng, nh := 1, 0, 0
+
+	// initialise optimiser: four components must be located at 1
+	opt.Init(GenTrialSolutions, nil, func(f, g, h, x []float64, ξ []int, cpu int) {
+		n := float64(len(x))
+		f[0] = (n - 4) * (n - 4)
+		for _, v := range x {
+			f[0] += (v - 1) * (v - 1)
+		}
+	}, nf, ng, nh)
+
+	// solve
+	opt.Solve()
+
+	// check
+	SortByOva(opt.Solutions, 0)
+	best := opt.Solutions[0]
+	io.Pforan("best = %v  f = %v\n", best.Flt, best.Ova[0])
+	chk.IntAssert(best.Ngenes(), 4)
+	chk.Scalar(tst, "f", 1e-2, best.Ova[0], 0)
+}
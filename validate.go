@@ -0,0 +1,316 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/utl"
+)
+
+// ReadErr reads configuration parameters from JSON (or YAML/TOML; see Read) file using a strict decoder
+//  Note: unknown (e.g. misspelled) keys and values of wrong types are reported as errors.
+//        Consistency is not checked; see Validate
+func (o *Parameters) ReadErr(filenamepath string) (err error) {
+	o.Default()
+	b, err := io.ReadFile(filenamepath)
+	if err != nil {
+		return chk.Err("cannot read parameters file %q:\n%v", filenamepath, err)
+	}
+	b, err = toJSON(b, filenamepath)
+	if err != nil {
+		return chk.Err("cannot read parameters file %q:\n%v", filenamepath, err)
+	}
+	err = o.DecodeStrict(b)
+	if err != nil {
+		return chk.Err("cannot read parameters file %q:\n%v", filenamepath, err)
+	}
+	return
+}
+
+// DecodeStrict decodes JSON data into parameters (without setting defaults)
+//  Note: all unknown keys at the top level are reported at once, with suggestions of known keys
+//        with similar names. Unknown keys of nested structures (e.g. Sched) are also errors
+func (o *Parameters) DecodeStrict(b []byte) (err error) {
+
+	// known keys
+	var raw map[string]json.RawMessage
+	err = json.Unmarshal(b, &raw)
+	if err != nil {
+		return chk.Err("cannot unmarshal parameters:\n%v", err)
+	}
+	known := jsonKeys(reflect.TypeOf(*o))
+	var unknown []string
+	for key := range raw {
+		if _, ok := known[strings.ToLower(key)]; ok {
+			continue
+		}
+		msg := io.Sf("%q", key)
+		if similar := closestKey(key, known); similar != "" {
+			msg += io.Sf(" (did you mean %q?)", similar)
+		}
+		unknown = append(unknown, msg)
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return chk.Err("unknown keys in parameters: %s", strings.Join(unknown, ", "))
+	}
+
+	// decode
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(o)
+	if err != nil {
+		return chk.Err("cannot unmarshal parameters:\n%v", err)
+	}
+	return
+}
+
+// Validate checks the consistency of parameters and returns all problems at once
+//  Note: parameters are not modified. CalcDerived calls Validate and panics if problems are
+//        found. Nova must be set; see Optimiser.InitErr
+func (o *Parameters) Validate() error {
+
+	// problems
+	var msgs []string
+	add := func(msg string, prm ...interface{}) {
+		msgs = append(msgs, io.Sf(msg, prm...))
+	}
+
+	// sizes
+	if o.Nova < 1 {
+		add("number of objective values (nova) must be greater than 0")
+	}
+	if o.Nsol < 6 {
+		add("number of solutions must greater than 6. Nsol = %d is invalid", o.Nsol)
+	}
+	if o.Ncpu > 1 && o.Ncpu > o.Nsol/2 {
+		add("number of CPU must be smaller than or equal to half the number of solutions. Ncpu=%d > Nsol/2=%d", o.Ncpu, o.Nsol/2)
+	}
+
+	// floats
+	nflt := len(o.FltMin)
+	if nflt == 0 && len(o.FltSets) > 0 && len(o.FltMax) == 0 {
+		nflt = len(o.FltSets)
+	} else if len(o.FltMax) != nflt {
+		add("FltMin and FltMax must have the same length. %d != %d", nflt, len(o.FltMax))
+	} else {
+		for i := 0; i < nflt; i++ {
+			discrete := i < len(o.FltSets) && len(o.FltSets[i]) > 0
+			if !discrete && o.FltMin[i] > o.FltMax[i] {
+				add("FltMin[%d] must be smaller than or equal to FltMax[%d]. %g > %g", i, i, o.FltMin[i], o.FltMax[i])
+			}
+		}
+	}
+	if len(o.FltSets) > 0 && len(o.FltSets) != nflt {
+		add("FltSets must have one entry per float. %d != %d", len(o.FltSets), nflt)
+	}
+	if len(o.FltBryVar) > 0 && len(o.FltBryVar) != nflt {
+		add("FltBryVar must have one entry per float. %d != %d", len(o.FltBryVar), nflt)
+	}
+	if !fltBryValid(o.FltBry) {
+		add("boundary handling of floats %q is invalid. options are 'proj', 'reflect', 'random', 'midpoint' or 'periodic'", o.FltBry)
+	}
+	for i, kind := range o.FltBryVar {
+		if kind != "" && !fltBryValid(kind) {
+			add("boundary handling of float %d %q is invalid", i, kind)
+		}
+	}
+
+	// ints
+	nint := len(o.IntMin)
+	if nint == 0 && len(o.IntCats) > 0 && len(o.IntMax) == 0 {
+		nint = len(o.IntCats)
+	} else if len(o.IntMax) != nint {
+		add("IntMin and IntMax must have the same length. %d != %d", nint, len(o.IntMax))
+	} else {
+		for i := 0; i < nint; i++ {
+			categorical := i < len(o.IntCats) && len(o.IntCats[i]) > 0
+			if !categorical && o.IntMin[i] > o.IntMax[i] {
+				add("IntMin[%d] must be smaller than or equal to IntMax[%d]. %d > %d", i, i, o.IntMin[i], o.IntMax[i])
+			}
+		}
+	}
+	if len(o.IntCats) > 0 {
+		if len(o.IntCats) != nint {
+			add("IntCats must have one entry per int. %d != %d", len(o.IntCats), nint)
+		}
+		if o.BinInt > 0 || o.PermInt > 0 {
+			add("categorical ints cannot be used with BinInt or PermInt")
+		}
+	}
+	if o.FltBits > 0 {
+		if o.FltBits < 2 || o.FltBits > 52 {
+			add("number of bits per float must be in [2,52]. FltBits=%d is invalid", o.FltBits)
+		}
+		if nflt == 0 || nint > 0 || o.PermInt > 0 {
+			add("binary encoding of floats (FltBits > 0) requires floats and cannot be combined with other ints")
+		}
+	}
+	if o.BinInt > 0 && o.PermInt > 0 {
+		add("BinInt and PermInt cannot be used at the same time. BinInt=%d, PermInt=%d", o.BinInt, o.PermInt)
+	}
+	if o.PermInt > 0 && o.PermDist != "adjacency" && o.PermDist != "kendall" {
+		add("distance between permutations %q is invalid. options are 'adjacency' or 'kendall'", o.PermDist)
+	}
+	if (o.Perm2opt || o.PermOrOp) && o.PermInt == 0 {
+		add("improvement of permutations (Perm2opt or PermOrOp) requires PermInt > 0")
+	}
+	if nflt == 0 && nint == 0 && o.BinInt == 0 && o.PermInt == 0 {
+		add("either floats and ints must be set (via FltMin/Max or IntMin/Max)")
+	}
+	if o.IntDE && (o.BinInt > 0 || o.PermInt > 0 || o.FltBits > 0) {
+		add("discrete differential evolution (IntDE) cannot be used with BinInt or PermInt")
+	}
+
+	// options
+	switch o.GenType {
+	case "latin", "halton", "rnd", "sobol", "maximin", "opposition":
+	default:
+		add("generation type %q is invalid. options are 'latin', 'halton', 'rnd', 'sobol', 'maximin' or 'opposition'", o.GenType)
+	}
+	if o.ConvHist != "" && o.ConvHist != "exc" && o.ConvHist != "out" {
+		add("convergence history option %q is invalid. options are 'exc' or 'out'", o.ConvHist)
+	}
+	for _, p := range []struct {
+		name string
+		val  float64
+	}{{"DEC", o.DEC}, {"IntPc", o.IntPc}, {"IntPm", o.IntPm}, {"VarPc", o.VarPc}, {"VarPins", o.VarPins}, {"VarPdel", o.VarPdel}, {"MshPm", o.MshPm}} {
+		if p.val < 0 || p.val > 1 {
+			add("%s must be in [0,1]. %s=%g is invalid", p.name, p.name, p.val)
+		}
+	}
+	if o.UseMesh && o.FltBits > 0 {
+		add("meshes (UseMesh) cannot be used with binary encoding of floats (FltBits > 0)")
+	}
+	if o.UseMesh && !o.GenAll {
+		add("meshes (UseMesh) require the generation of all solutions together (GenAll) because the extra (boundary) solutions are appended to all solutions")
+	}
+	if o.VarLen {
+		if o.BinInt > 0 || o.PermInt > 0 || o.FltBits > 0 || o.UseMesh {
+			add("variable-length chromosomes cannot be used with BinInt, PermInt or UseMesh")
+		}
+		if o.LenMin < 1 || o.LenMax < o.LenMin {
+			add("number of genes must satisfy 1 ≤ LenMin ≤ LenMax. LenMin=%d and LenMax=%d are invalid", o.LenMin, o.LenMax)
+		}
+	}
+	if o.LsType != "" {
+		if o.LsType != "nm" && o.LsType != "hj" && o.LsType != "cs" {
+			add("local search method %q is invalid. options are 'nm', 'hj' or 'cs'", o.LsType)
+		}
+		if nflt == 0 || o.VarLen || o.FltBits > 0 {
+			add("local search requires fixed-length floats that are not binary encoded")
+		}
+	}
+
+	// lexicographic optimisation
+	if o.Lexico && o.Nova > 0 {
+		if len(o.LexPrio) > 0 {
+			used := make([]bool, o.Nova)
+			valid := len(o.LexPrio) == o.Nova
+			for _, idx := range o.LexPrio {
+				if idx < 0 || idx >= o.Nova || used[idx] {
+					valid = false
+					break
+				}
+				used[idx] = true
+			}
+			if !valid {
+				add("LexPrio must be a permutation of objective indices. LexPrio = %v is invalid", o.LexPrio)
+			}
+		}
+		if len(o.LexTols) > 0 && len(o.LexTols) != o.Nova {
+			add("LexTols must have one entry per objective. %d != %d", len(o.LexTols), o.Nova)
+		}
+	}
+
+	// time-varying parameters
+	for _, key := range o.SchedKeys() {
+		if o.schedTarget(key) == nil {
+			add("parameter %q cannot be scheduled. options are 'DEC', 'IntPc', 'IntPm' or 'EpsH'", key)
+			continue
+		}
+		if err := o.Sched[key].check(key); err != nil {
+			add("%v", err)
+			continue
+		}
+		if rng, ok := prmsRanges[key]; ok {
+			lo, hi := rng.bounds()
+			if err := o.Sched[key].checkRange(key, lo, hi); err != nil {
+				add("%v", err)
+			}
+		}
+	}
+
+	// results
+	if len(msgs) > 0 {
+		return chk.Err("%d problem(s) found in parameters:\n  %s", len(msgs), strings.Join(msgs, "\n  "))
+	}
+	return nil
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// jsonKeys returns the (lowercase) JSON keys of exported fields of struct t mapped to field names
+func jsonKeys(t reflect.Type) (keys map[string]string) {
+	keys = make(map[string]string)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" { // unexported
+			continue
+		}
+		name := f.Name
+		if tag := f.Tag.Get("json"); tag != "" {
+			name = strings.Split(tag, ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+		}
+		keys[strings.ToLower(name)] = name
+	}
+	return
+}
+
+// closestKey returns the known key most similar to key (edit distance ≤ 2); or "" if none
+func closestKey(key string, known map[string]string) (res string) {
+	best := 3
+	for lower, name := range known {
+		d := editDistance(strings.ToLower(key), lower)
+		if d < best || (d == best && name < res) {
+			best, res = d, name
+		}
+	}
+	return
+}
+
+// editDistance computes the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev, curr := make([]int, len(t)+1), make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		curr[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			curr[j] = utl.Imin(utl.Imin(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(t)]
+}
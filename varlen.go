@@ -0,0 +1,168 @@
+// Copyright 2015 Dorival de Moraes Pedroso. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package goga
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/rnd"
+	"github.com/cpmech/gosl/utl"
+)
+
+// variable-length chromosomes
+//  A chromosome holds n genes with LenMin ≤ n ≤ LenMax. Each gene is a block of Nflt floats and
+//  Nint ints; e.g. the coordinates and type of one component in a layout problem. Thus:
+//     Flt = [x(0,0) x(0,1) ... x(0,Nflt-1) | x(1,0) ... | ... | x(n-1,Nflt-1)]
+//     Int = [y(0,0) y(0,1) ... y(0,Nint-1) | y(1,0) ... | ... | y(n-1,Nint-1)]
+//  and FltMin/FltMax and IntMin/IntMax give the ranges of the values within one gene
+
+// GenVarLen generates (initial) solutions with variable-length chromosomes
+//  Note: the number of genes is uniformly selected in [LenMin, LenMax]
+func GenVarLen(sols []*Solution, prms *Parameters) {
+	for _, sol := range sols {
+		n := rnd.Int(prms.LenMin, prms.LenMax)
+		sol.Flt = sol.Flt[:n*prms.Nflt]
+		sol.Int = sol.Int[:n*prms.Nint]
+		for g := 0; g < n; g++ {
+			genGene(sol, g, prms)
+		}
+	}
+}
+
+// CxVarLen performs the cut-and-splice crossover of variable-length chromosomes
+//  Output: new individuals 'a' and 'b'
+//  Note: the cuts are located between genes and selected such that the number of genes of the
+//        offspring remains within [LenMin, LenMax]
+//  Example:
+//     A = a0 a1 a2 a3 a4    s = 3
+//     B = b0 b1 b2          t = 1
+//  gives:
+//     a = a0 a1 a2 b1 b2
+//     b = b0 a3 a4
+func CxVarLen(a, b, A, B *Solution, prms *Parameters) {
+	if !rnd.FlipCoin(prms.VarPc) {
+		A.CopyInto(a)
+		B.CopyInto(b)
+		return
+	}
+	na, nb := A.Ngenes(), B.Ngenes()
+	s := rnd.Int(0, na)
+	tmin := utl.Imax(utl.Imax(0, s+nb-prms.LenMax), prms.LenMin-na+s)
+	tmax := utl.Imin(utl.Imin(nb, s+nb-prms.LenMin), prms.LenMax-na+s)
+	if tmin > tmax { // there is always a solution with swapped lengths
+		s = rnd.Int(utl.Imax(0, na-nb), na)
+		tmin, tmax = nb-na+s, nb-na+s
+	}
+	cxVarLen(a, b, A, B, s, rnd.Int(tmin, tmax))
+}
+
+// MtVarLen performs the insert and delete gene mutations of variable-length chromosomes
+//  Output: modified individual 'A'
+//  Note: a random gene is inserted at a random position with probability VarPins if A has less
+//        than LenMax genes; then a random gene is deleted with probability VarPdel if A has more
+//        than LenMin genes
+func MtVarLen(A *Solution, prms *Parameters) {
+	n := A.Ngenes()
+	if n < prms.LenMax && rnd.FlipCoin(prms.VarPins) {
+		g := rnd.Int(0, n)
+		mtVarLenIns(A, g)
+		genGene(A, g, prms)
+		n++
+	}
+	if n > prms.LenMin && rnd.FlipCoin(prms.VarPdel) {
+		mtVarLenDel(A, rnd.Int(0, n-1))
+	}
+}
+
+// DiffEvolVarLen performs the differential-evolution operation on the values of the genes of a
+// variable-length chromosome
+//  Output: modified individual 'a'
+//  Note: gene g of 'a' is combined with genes g mod n0, g mod n1 and g mod n2 of A0, A1 and A2,
+//        where n0, n1 and n2 are their numbers of genes. Ints are handled as in DiffEvolInt
+func DiffEvolVarLen(a, A0, A1, A2 *Solution, prms *Parameters) {
+	nf, ni := prms.Nflt, prms.Nint
+	n, n0, n1, n2 := a.Ngenes(), A0.Ngenes(), A1.Ngenes(), A2.Ngenes()
+	F := rnd.Float64(0.0, 1.0)
+	I := rnd.Int(0, n*(nf+ni)-1)
+	for g := 0; g < n; g++ {
+		g0, g1, g2 := g%n0, g%n1, g%n2
+		for i := 0; i < nf; i++ {
+			if rnd.FlipCoin(prms.DEC) || g*nf+i == I {
+				x := A0.Flt[g0*nf+i] + F*(A1.Flt[g1*nf+i]-A2.Flt[g2*nf+i])
+				a.Flt[g*nf+i] = prms.FltBoundary(i, x, a.Flt[g*nf+i], prms.FltMin[i], prms.FltMax[i])
+			}
+		}
+		prms.SnapFlt(a.Flt[g*nf : (g+1)*nf])
+		for i := 0; i < ni; i++ {
+			if rnd.FlipCoin(prms.DEC) || n*nf+g*ni+i == I {
+				y0, y1, y2 := A0.Int[g0*ni+i], A1.Int[g1*ni+i], A2.Int[g2*ni+i]
+				if prms.IsCat(i) {
+					if y1 == y2 {
+						a.Int[g*ni+i] = y0
+					} else {
+						a.Int[g*ni+i] = rnd.Int(prms.IntMin[i], prms.IntMax[i])
+					}
+					continue
+				}
+				a.Int[g*ni+i] = prms.EnforceIntRange(i, y0+int(math.Floor(F*float64(y1-y2)+0.5)))
+			}
+		}
+	}
+}
+
+// auxiliary //////////////////////////////////////////////////////////////////////////////////////
+
+// genGene generates random values for gene g of sol
+func genGene(sol *Solution, g int, prms *Parameters) {
+	nf, ni := prms.Nflt, prms.Nint
+	for i := 0; i < nf; i++ {
+		sol.Flt[g*nf+i] = rnd.Float64(prms.FltMin[i], prms.FltMax[i])
+	}
+	prms.SnapFlt(sol.Flt[g*nf : (g+1)*nf])
+	for i := 0; i < ni; i++ {
+		sol.Int[g*ni+i] = rnd.Int(prms.IntMin[i], prms.IntMax[i])
+	}
+}
+
+// cxVarLen splices A[:s] with B[t:] into a and B[:t] with A[s:] into b
+//  s -- cut position (in genes) of A
+//  t -- cut position (in genes) of B
+func cxVarLen(a, b, A, B *Solution, s, t int) {
+	nf, ni := A.prms.Nflt, A.prms.Nint
+	na, nb := A.Ngenes(), B.Ngenes()
+	a.Flt = a.Flt[:(s+nb-t)*nf]
+	a.Int = a.Int[:(s+nb-t)*ni]
+	b.Flt = b.Flt[:(t+na-s)*nf]
+	b.Int = b.Int[:(t+na-s)*ni]
+	copy(a.Flt, A.Flt[:s*nf])
+	copy(a.Flt[s*nf:], B.Flt[t*nf:])
+	copy(a.Int, A.Int[:s*ni])
+	copy(a.Int[s*ni:], B.Int[t*ni:])
+	copy(b.Flt, B.Flt[:t*nf])
+	copy(b.Flt[t*nf:], A.Flt[s*nf:])
+	copy(b.Int, B.Int[:t*ni])
+	copy(b.Int[t*ni:], A.Int[s*ni:])
+}
+
+// mtVarLenIns opens room for a new gene at position g of A; i.e. genes g,g+1,... are shifted
+//  Note: the values of the new gene are not set
+func mtVarLenIns(A *Solution, g int) {
+	nf, ni := A.prms.Nflt, A.prms.Nint
+	n := A.Ngenes()
+	A.Flt = A.Flt[:(n+1)*nf]
+	A.Int = A.Int[:(n+1)*ni]
+	copy(A.Flt[(g+1)*nf:], A.Flt[g*nf:n*nf])
+	copy(A.Int[(g+1)*ni:], A.Int[g*ni:n*ni])
+}
+
+// mtVarLenDel deletes gene g of A
+func mtVarLenDel(A *Solution, g int) {
+	nf, ni := A.prms.Nflt, A.prms.Nint
+	n := A.Ngenes()
+	copy(A.Flt[g*nf:], A.Flt[(g+1)*nf:])
+	copy(A.Int[g*ni:], A.Int[(g+1)*ni:])
+	A.Flt = A.Flt[:(n-1)*nf]
+	A.Int = A.Int[:(n-1)*ni]
+}